@@ -0,0 +1,9 @@
+data "looker_connection_columns" "orders" {
+  connection_name = looker_connection.warehouse.name
+  schema_name     = "ANALYTICS"
+  table_names     = ["ORDERS"]
+}
+
+output "order_dimensions" {
+  value = { for column in data.looker_connection_columns.orders.columns : lower(column.name) => column.data_type_looker }
+}
@@ -0,0 +1,11 @@
+data "looker_connection_schemas" "warehouse" {
+  connection_name = looker_connection.warehouse.name
+  cache           = false
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.schemas[*].name, "ANALYTICS")
+      error_message = "The warehouse connection can't see the ANALYTICS schema."
+    }
+  }
+}
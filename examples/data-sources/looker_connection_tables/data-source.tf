@@ -0,0 +1,8 @@
+data "looker_connection_tables" "analytics" {
+  connection_name = looker_connection.warehouse.name
+  schema_name     = "ANALYTICS"
+}
+
+output "analytics_tables" {
+  value = data.looker_connection_tables.analytics.tables[*].name
+}
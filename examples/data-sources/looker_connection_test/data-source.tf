@@ -0,0 +1,8 @@
+data "looker_connection_test" "warehouse" {
+  connection_name = looker_connection.snowflake_connection.name
+  tests           = ["connect", "query", "pdt"]
+}
+
+output "warehouse_test_failures" {
+  value = [for r in data.looker_connection_test.warehouse.results : "${r.name}: ${r.message}" if r.status == "error"]
+}
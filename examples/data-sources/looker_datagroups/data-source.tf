@@ -0,0 +1,7 @@
+data "looker_datagroups" "ecommerce" {
+  model_name = looker_lookml_model.ecommerce.name
+}
+
+output "datagroup_trigger_errors" {
+  value = { for dg in data.looker_datagroups.ecommerce.datagroups : dg.name => dg.trigger_error if dg.trigger_error != "" }
+}
@@ -0,0 +1,5 @@
+data "looker_dialects" "all" {}
+
+output "installed_dialects" {
+  value = [for dialect in data.looker_dialects.all.dialects : dialect.name if dialect.installed]
+}
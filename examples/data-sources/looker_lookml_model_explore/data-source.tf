@@ -0,0 +1,8 @@
+data "looker_lookml_model_explore" "orders" {
+  model_name   = looker_lookml_model.ecommerce.name
+  explore_name = "orders"
+}
+
+output "order_measures" {
+  value = [for m in data.looker_lookml_model_explore.orders.measures : m.name if !m.hidden]
+}
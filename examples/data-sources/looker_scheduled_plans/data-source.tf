@@ -0,0 +1,17 @@
+# schedules owned by a user who is leaving
+data "looker_scheduled_plans" "owned" {
+  user_id = looker_user.departing.id
+}
+
+# schedules sending data outside the company
+data "looker_scheduled_plans" "external" {
+  destination_domain = "partner.example.com"
+}
+
+output "schedules_to_reassign" {
+  value = [for p in data.looker_scheduled_plans.owned.scheduled_plans : {
+    name       = p.name
+    crontab    = p.crontab
+    recipients = p.recipients
+  }]
+}
@@ -0,0 +1,15 @@
+resource "looker_alert" "daily_orders" {
+  dashboard_element_id = looker_dashboard.kpis.element_ids[0]
+  field                = "orders.count"
+  comparison_type      = "LESS_THAN"
+  threshold            = 100
+  cron                 = "0 9 * * *"
+  owner_id             = looker_user.on_call.id
+  custom_title         = "Daily orders dropped below 100"
+  is_public            = true
+
+  destination {
+    type          = "EMAIL"
+    email_address = looker_user.on_call.email
+  }
+}
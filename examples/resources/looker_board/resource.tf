@@ -0,0 +1,26 @@
+resource "looker_board" "finance" {
+  title       = "Finance"
+  description = "Landing page for the finance team"
+
+  section {
+    title = "Daily"
+
+    item {
+      dashboard_id = looker_dashboard.sales.id
+    }
+
+    item {
+      look_id = looker_look.weekly_orders.id
+      title   = "Orders this week"
+    }
+  }
+
+  section {
+    title = "References"
+
+    item {
+      url   = "https://wiki.example.com/finance/metrics"
+      title = "Metric definitions"
+    }
+  }
+}
@@ -1,12 +1,24 @@
 resource "looker_connection" "bigquery_connection" {
-  name         = "bigquery_connection"
-  host         = "gcp_project_id"
-  user         = var.gcp_service_account_email
-  certificate  = filebase64("path/to/sa.json")
-  file_type    = ".json"
-  database     = "dataset_name"
+  name             = "bigquery_connection"
+  host             = "gcp_project_id"
+  user             = var.gcp_service_account_email
+  certificate_file = "path/to/sa.json"
+  database         = "dataset_name"
+  tmp_db_name      = "tmp_dataset_name"
+  dialect_name     = "bigquery_standard_sql"
+}
+
+resource "looker_connection" "bigquery_connection_with_block" {
+  name         = "bigquery_connection_with_block"
   tmp_db_name  = "tmp_dataset_name"
   dialect_name = "bigquery_standard_sql"
+
+  bigquery {
+    project               = "gcp_project_id"
+    dataset               = "dataset_name"
+    service_account_json  = file("path/to/sa.json")
+    max_billing_gigabytes = "100"
+  }
 }
 
 resource "looker_connection" "snowflake_connection" {
@@ -15,6 +27,7 @@ resource "looker_connection" "snowflake_connection" {
   port                   = 443
   user                   = var.snowflake_username
   password               = var.snowflake_password
+  password_version       = var.snowflake_password_version
   database               = "DATABASE"
   db_timezone            = "UTC"
   query_timezone         = "UTC"
@@ -23,4 +36,27 @@ resource "looker_connection" "snowflake_connection" {
   tmp_db_name            = "tmp_dataset_name"
   jdbc_additional_params = "account=${var.snowflake_account}&warehouse=WHARE_HOUSE"
   dialect_name           = "snowflake"
+
+  pdt_context_override {
+    username = var.snowflake_pdt_username
+    password = var.snowflake_pdt_password
+    schema   = "LOOKER_PDT"
+  }
+
+  test_on_apply {
+    tests = ["connect", "query", "tmp_table"]
+  }
+}
+
+resource "looker_connection" "postgres_connection" {
+  name         = "postgres_connection"
+  username     = var.postgres_username
+  password     = var.postgres_password
+  dialect_name = "postgres"
+
+  postgres {
+    host     = "warehouse.internal"
+    database = "analytics"
+    ssl      = true
+  }
 }
@@ -0,0 +1,36 @@
+resource "looker_dashboard" "sales" {
+  title     = "Sales Overview"
+  folder_id = "1"
+
+  definition = jsonencode({
+    filters = [{
+      name          = "order_date"
+      title         = "Order Date"
+      type          = "field_filter"
+      model         = "ecommerce"
+      explore       = "orders"
+      dimension     = "orders.created_date"
+      default_value = "30 days"
+    }]
+    elements = [{
+      title = "Revenue by Month"
+      type  = "vis"
+      query = {
+        model  = "ecommerce"
+        view   = "orders"
+        fields = ["orders.created_month", "orders.total_revenue"]
+        sorts  = ["orders.created_month desc"]
+        limit  = "500"
+        vis_config = {
+          type = "looker_line"
+        }
+      }
+      layout = {
+        row    = 0
+        column = 0
+        width  = 12
+        height = 6
+      }
+    }]
+  })
+}
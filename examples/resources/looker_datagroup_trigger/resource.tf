@@ -0,0 +1,9 @@
+# rebuild the PDTs of the orders datagroup after each warehouse schema migration
+resource "looker_datagroup_trigger" "orders" {
+  model_name = looker_lookml_model.ecommerce.name
+  name       = "orders_datagroup"
+
+  triggers = {
+    schema_version = var.warehouse_schema_version
+  }
+}
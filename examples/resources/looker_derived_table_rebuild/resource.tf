@@ -0,0 +1,15 @@
+# rebuild the orders rollup and the PDTs it depends on after the warehouse schema changes
+resource "looker_derived_table_rebuild" "orders_rollup" {
+  model_name    = looker_lookml_model.ecommerce.name
+  view_name     = "orders_rollup"
+  force_rebuild = true
+
+  triggers = {
+    schema_version = var.warehouse_schema_version
+    connection     = looker_connection.warehouse.name
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,17 @@
+resource "looker_external_oauth_application" "snowflake" {
+  name                  = "ANALYTICS"
+  client_id             = var.snowflake_oauth_client_id
+  client_secret         = var.snowflake_oauth_client_secret
+  client_secret_version = var.snowflake_oauth_client_secret_version
+  dialect_name          = "snowflake"
+}
+
+resource "looker_connection" "snowflake" {
+  name                   = "snowflake"
+  host                   = var.snowflake_host
+  username               = var.snowflake_username
+  database               = "ANALYTICS"
+  jdbc_additional_params = "account=${var.snowflake_account}&warehouse=LOOKER"
+  dialect_name           = "snowflake"
+  oauth_application_id   = looker_external_oauth_application.snowflake.id
+}
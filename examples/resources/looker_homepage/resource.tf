@@ -0,0 +1,4 @@
+resource "looker_homepage" "finance" {
+  group_id = looker_group.finance.id
+  board_id = looker_board.finance.id
+}
@@ -0,0 +1,24 @@
+resource "looker_ldap_config" "corp" {
+  enabled               = true
+  connection_host       = "ldap.example.com"
+  connection_port       = "636"
+  auth_username         = "cn=looker,ou=services,dc=example,dc=com"
+  auth_password         = var.ldap_bind_password
+  auth_password_version = "2022-05-01"
+
+  user_bind_base_dn  = "ou=people,dc=example,dc=com"
+  user_custom_filter = "(memberOf=cn=looker-users,ou=groups,dc=example,dc=com)"
+
+  groups_base_dn        = "ou=groups,dc=example,dc=com"
+  set_roles_from_groups = true
+  auth_requires_role    = true
+
+  group {
+    name     = "looker-developers"
+    role_ids = [looker_role.developer.id]
+  }
+
+  test_user {
+    login = "looker-test"
+  }
+}
@@ -0,0 +1,19 @@
+resource "looker_look" "weekly_orders" {
+  title       = "Weekly Orders"
+  description = "Orders per week for the last quarter"
+  folder_id   = "1"
+
+  query {
+    model  = "ecommerce"
+    view   = "orders"
+    fields = ["orders.created_week", "orders.count"]
+    filters = {
+      "orders.created_date" = "3 months"
+    }
+    sorts = ["orders.created_week desc"]
+    limit = "500"
+    vis_config = jsonencode({
+      type = "looker_column"
+    })
+  }
+}
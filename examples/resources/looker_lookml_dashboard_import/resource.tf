@@ -0,0 +1,8 @@
+resource "looker_lookml_dashboard_import" "sales_overview" {
+  lookml_dashboard_id = "ecommerce::sales_overview"
+  folder_id           = var.sales_team_folder_id
+  title               = "Sales Overview (Sales Team)"
+
+  # re-sync the copy whenever the LookML project is deployed
+  sync_trigger = var.lookml_project_commit_sha
+}
@@ -0,0 +1,12 @@
+resource "looker_oauth_client_app" "notebooks" {
+  client_guid    = "notebooks"
+  redirect_uri   = "https://notebooks.example.com/oauth/looker/callback"
+  display_name   = "Notebooks"
+  description    = "Query Looker from the data science notebooks"
+  group_id       = looker_group.data_science.id
+  tokens_version = "2022-05-01"
+
+  revoked_user_ids = [
+    looker_user.former_contractor.id,
+  ]
+}
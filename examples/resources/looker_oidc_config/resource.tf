@@ -0,0 +1,22 @@
+resource "looker_oidc_config" "auth0" {
+  enabled                = true
+  issuer                 = "https://example.auth0.com/"
+  authorization_endpoint = "https://example.auth0.com/authorize"
+  token_endpoint         = "https://example.auth0.com/oauth/token"
+  userinfo_endpoint      = "https://example.auth0.com/userinfo"
+  identifier             = var.oidc_client_id
+  secret                 = var.oidc_client_secret
+  secret_version         = "2022-05-01"
+  scopes                 = ["openid", "email", "profile"]
+
+  new_user_migration_types  = ["email"]
+  default_new_user_role_ids = [looker_role.viewer.id]
+
+  set_roles_from_groups = true
+  groups_attribute      = "https://looker.example.com/groups"
+
+  group {
+    name     = "looker-developers"
+    role_ids = [looker_role.developer.id]
+  }
+}
@@ -0,0 +1,6 @@
+resource "looker_password_config" "this" {
+  min_length         = 12
+  require_numeric    = true
+  require_upperlower = true
+  require_special    = true
+}
@@ -0,0 +1,28 @@
+resource "looker_saml_config" "okta" {
+  enabled    = true
+  idp_url    = "https://example.okta.com/app/looker/exk1a2b3c4/sso/saml"
+  idp_issuer = "http://www.okta.com/exk1a2b3c4"
+  idp_cert   = file("${path.module}/okta.pem")
+
+  new_user_migration_types  = ["email"]
+  default_new_user_role_ids = [looker_role.viewer.id]
+
+  set_roles_from_groups = true
+  groups_attribute      = "groups"
+  auth_requires_role    = true
+
+  group {
+    name     = "looker-developers"
+    role_ids = [looker_role.developer.id]
+  }
+
+  group {
+    name     = "looker-admins"
+    role_ids = [looker_role.admin.id]
+  }
+
+  user_attribute {
+    name               = "department"
+    user_attribute_ids = [looker_user_attribute.department.id]
+  }
+}
@@ -0,0 +1,28 @@
+resource "looker_scheduled_plan" "weekly_orders" {
+  name             = "Weekly orders"
+  look_id          = looker_look.weekly_orders.id
+  crontab          = "0 6 * * 1"
+  timezone         = "America/New_York"
+  run_as_recipient = false
+  filters_string   = "?orders.status=complete"
+
+  destination {
+    type             = "email"
+    address          = "analytics@example.com"
+    format           = "csv_zip"
+    apply_formatting = true
+  }
+
+  destination {
+    type    = "s3"
+    address = "s3://example-reports/weekly-orders"
+    format  = "csv"
+    parameters = jsonencode({
+      region        = "us-east-1"
+      access_key_id = var.reports_access_key_id
+    })
+    secret_parameters = jsonencode({
+      secret_access_key = var.reports_secret_access_key
+    })
+  }
+}
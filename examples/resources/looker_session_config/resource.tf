@@ -0,0 +1,6 @@
+resource "looker_session_config" "this" {
+  session_minutes             = 720
+  allow_persistent_sessions   = false
+  unlimited_sessions_per_user = false
+  track_session_location      = true
+}
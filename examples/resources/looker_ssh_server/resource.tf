@@ -0,0 +1,10 @@
+resource "looker_ssh_server" "bastion" {
+  name     = "bastion"
+  host     = "bastion.example.com"
+  username = "looker"
+}
+
+# add to ~looker/.ssh/authorized_keys on the bastion
+output "looker_public_key" {
+  value = looker_ssh_server.bastion.public_key
+}
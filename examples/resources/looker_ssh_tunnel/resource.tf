@@ -0,0 +1,22 @@
+resource "looker_ssh_server" "bastion" {
+  name     = "bastion"
+  host     = "bastion.example.com"
+  username = "looker"
+}
+
+resource "looker_ssh_tunnel" "warehouse" {
+  ssh_server_id = looker_ssh_server.bastion.id
+  database_host = "warehouse.internal"
+  database_port = 5432
+}
+
+resource "looker_connection" "warehouse" {
+  name         = "warehouse"
+  host         = "warehouse.internal"
+  port         = "5432"
+  username     = var.warehouse_username
+  password     = var.warehouse_password
+  database     = "analytics"
+  dialect_name = "postgres"
+  tunnel_id    = looker_ssh_tunnel.warehouse.id
+}
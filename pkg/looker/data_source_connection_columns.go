@@ -0,0 +1,151 @@
+package looker
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsConnectionColumnsSchema = map[string]*schema.Schema{
+	"connection_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Description: "Database to list the columns of, for dialects that support multiple databases.",
+		Optional:    true,
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Description: "Only return the columns of tables in this schema.",
+		Optional:    true,
+	},
+	"table_names": {
+		Type:        schema.TypeList,
+		Description: "Only return the columns of these tables.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"table_limit": {
+		Type:        schema.TypeInt,
+		Description: "Maximum number of tables to return the columns of per schema.",
+		Optional:    true,
+	},
+	"cache": {
+		Type:        schema.TypeBool,
+		Description: "Whether to use the columns cached by Looker. Set to `false` to load them from the database.",
+		Optional:    true,
+		Default:     true,
+	},
+	"columns": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schema_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"table_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"sql_escaped_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"data_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"data_type_database": {
+					Type:        schema.TypeString,
+					Description: "Data type of the column in the SQL dialect of the database.",
+					Computed:    true,
+				},
+				"data_type_looker": {
+					Type:        schema.TypeString,
+					Description: "Looker type of the column, e.g. `number` or `string`.",
+					Computed:    true,
+				},
+				"column_size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func dsConnectionColumns() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadConnectionColumns,
+		Schema: dsConnectionColumnsSchema,
+	}
+}
+
+func dsReadConnectionColumns(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	connectionName := d.Get("connection_name").(string)
+	cache := d.Get("cache").(bool)
+
+	request := apiclient.RequestConnectionColumns{
+		ConnectionName: connectionName,
+		Cache:          &cache,
+	}
+	if v, ok := d.GetOk("database"); ok {
+		database := v.(string)
+		request.Database = &database
+	}
+	if v, ok := d.GetOk("schema_name"); ok {
+		schemaName := v.(string)
+		request.SchemaName = &schemaName
+	}
+	if tableNames := expandStringList(d.Get("table_names")); len(tableNames) > 0 {
+		names := strings.Join(tableNames, ",")
+		request.TableNames = &names
+	}
+	if v, ok := d.GetOk("table_limit"); ok {
+		tableLimit := int64(v.(int))
+		request.TableLimit = &tableLimit
+	}
+
+	tables, err := client.ConnectionColumns(request, nil)
+	if err != nil {
+		return err
+	}
+
+	vs := make([]interface{}, 0)
+	for _, table := range tables {
+		if table.Columns == nil {
+			continue
+		}
+		for _, column := range *table.Columns {
+			vs = append(vs, map[string]interface{}{
+				"schema_name":        derefString(table.SchemaName),
+				"table_name":         derefString(table.Name),
+				"name":               derefString(column.Name),
+				"sql_escaped_name":   derefString(column.SqlEscapedName),
+				"data_type":          derefString(column.DataType),
+				"data_type_database": derefString(column.DataTypeDatabase),
+				"data_type_looker":   derefString(column.DataTypeLooker),
+				"column_size":        derefInt64(column.ColumnSize),
+				"description":        derefString(column.Description),
+			})
+		}
+	}
+
+	d.SetId(connectionName)
+	return d.Set("columns", vs)
+}
@@ -0,0 +1,45 @@
+package looker
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDsReadConnectionColumns(t *testing.T) {
+	var gotTableNames, gotCache string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotTableNames = r.URL.Query().Get("table_names")
+		gotCache = r.URL.Query().Get("cache")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"name": "orders", "schema_name": "public", "columns": [
+				{"name": "id", "data_type_database": "int8", "data_type_looker": "number"},
+				{"name": "created_at", "data_type_database": "timestamp", "data_type_looker": "time"}
+			]},
+			{"name": "users", "schema_name": "public", "columns": [
+				{"name": "email", "data_type_database": "varchar", "data_type_looker": "string", "column_size": 255}
+			]}
+		]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dsConnectionColumnsSchema, map[string]interface{}{
+		"connection_name": "warehouse",
+		"table_names":     []interface{}{"orders", "users"},
+		"cache":           false,
+	})
+
+	err := dsReadConnectionColumns(d, client)
+	assert.NoError(t, err)
+	assert.Equal(t, "orders,users", gotTableNames)
+	assert.Equal(t, "false", gotCache)
+	assert.Equal(t, 3, d.Get("columns.#"))
+	assert.Equal(t, "orders", d.Get("columns.1.table_name"))
+	assert.Equal(t, "created_at", d.Get("columns.1.name"))
+	assert.Equal(t, "users", d.Get("columns.2.table_name"))
+	assert.Equal(t, "string", d.Get("columns.2.data_type_looker"))
+	assert.Equal(t, 255, d.Get("columns.2.column_size"))
+}
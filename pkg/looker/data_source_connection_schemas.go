@@ -0,0 +1,79 @@
+package looker
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsConnectionSchemasSchema = map[string]*schema.Schema{
+	"connection_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Description: "Database to list the schemas of, for dialects that support multiple databases.",
+		Optional:    true,
+	},
+	"cache": {
+		Type:        schema.TypeBool,
+		Description: "Whether to use the schemas cached by Looker. Set to `false` to load them from the database.",
+		Optional:    true,
+		Default:     true,
+	},
+	"schemas": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"is_default": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func dsConnectionSchemas() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadConnectionSchemas,
+		Schema: dsConnectionSchemasSchema,
+	}
+}
+
+func dsReadConnectionSchemas(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	connectionName := d.Get("connection_name").(string)
+	cache := d.Get("cache").(bool)
+
+	request := apiclient.RequestConnectionSchemas{
+		ConnectionName: connectionName,
+		Cache:          &cache,
+	}
+	if v, ok := d.GetOk("database"); ok {
+		database := v.(string)
+		request.Database = &database
+	}
+
+	schemas, err := client.ConnectionSchemas(request, nil)
+	if err != nil {
+		return err
+	}
+
+	vs := make([]interface{}, 0, len(schemas))
+	for _, s := range schemas {
+		vs = append(vs, map[string]interface{}{
+			"name":       derefString(s.Name),
+			"is_default": derefBool(s.IsDefault),
+		})
+	}
+
+	d.SetId(connectionName)
+	return d.Set("schemas", vs)
+}
@@ -0,0 +1,35 @@
+package looker
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDsReadConnectionSchemas(t *testing.T) {
+	var gotPath, gotCache string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotCache = r.URL.Query().Get("cache")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"name": "public", "is_default": true}, {"name": "looker_scratch"}]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dsConnectionSchemasSchema, map[string]interface{}{
+		"connection_name": "warehouse",
+		"cache":           false,
+	})
+
+	err := dsReadConnectionSchemas(d, client)
+	assert.NoError(t, err)
+	assert.Equal(t, "/api/4.0/connections/warehouse/schemas", gotPath)
+	assert.Equal(t, "false", gotCache)
+	assert.Equal(t, "warehouse", d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "public", "is_default": true},
+		map[string]interface{}{"name": "looker_scratch", "is_default": false},
+	}, d.Get("schemas"))
+}
@@ -0,0 +1,143 @@
+package looker
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsConnectionTablesSchema = map[string]*schema.Schema{
+	"connection_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"database": {
+		Type:        schema.TypeString,
+		Description: "Database to list the tables of, for dialects that support multiple databases.",
+		Optional:    true,
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Description: "Only return the tables of this schema.",
+		Optional:    true,
+	},
+	"table_filter": {
+		Type:        schema.TypeString,
+		Description: "Only return the tables whose name contains this value.",
+		Optional:    true,
+	},
+	"table_limit": {
+		Type:        schema.TypeInt,
+		Description: "Maximum number of tables to return per schema.",
+		Optional:    true,
+	},
+	"cache": {
+		Type:        schema.TypeBool,
+		Description: "Whether to use the tables cached by Looker. Set to `false` to load them from the database.",
+		Optional:    true,
+		Default:     true,
+	},
+	"table_limit_hit": {
+		Type:        schema.TypeBool,
+		Description: "Whether `table_limit` was hit in any of the schemas, so that tables are missing.",
+		Computed:    true,
+	},
+	"tables": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schema_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"sql_escaped_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"rows": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"external": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func dsConnectionTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadConnectionTables,
+		Schema: dsConnectionTablesSchema,
+	}
+}
+
+func dsReadConnectionTables(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	connectionName := d.Get("connection_name").(string)
+	cache := d.Get("cache").(bool)
+
+	request := apiclient.RequestConnectionTables{
+		ConnectionName: connectionName,
+		Cache:          &cache,
+	}
+	if v, ok := d.GetOk("database"); ok {
+		database := v.(string)
+		request.Database = &database
+	}
+	if v, ok := d.GetOk("schema_name"); ok {
+		schemaName := v.(string)
+		request.SchemaName = &schemaName
+	}
+	if v, ok := d.GetOk("table_filter"); ok {
+		tableFilter := v.(string)
+		request.TableFilter = &tableFilter
+	}
+	if v, ok := d.GetOk("table_limit"); ok {
+		tableLimit := int64(v.(int))
+		request.TableLimit = &tableLimit
+	}
+
+	schemaTables, err := client.ConnectionTables(request, nil)
+	if err != nil {
+		return err
+	}
+
+	tableLimitHit := false
+	vs := make([]interface{}, 0)
+	for _, s := range schemaTables {
+		if derefBool(s.TableLimitHit) {
+			tableLimitHit = true
+		}
+		if s.Tables == nil {
+			continue
+		}
+		for _, table := range *s.Tables {
+			schemaName := derefString(table.SchemaName)
+			if schemaName == "" {
+				schemaName = derefString(s.Name)
+			}
+			vs = append(vs, map[string]interface{}{
+				"schema_name":      schemaName,
+				"name":             derefString(table.Name),
+				"sql_escaped_name": derefString(table.SqlEscapedName),
+				"rows":             derefInt64(table.Rows),
+				"external":         derefString(table.External),
+			})
+		}
+	}
+
+	d.SetId(connectionName)
+
+	if err = d.Set("table_limit_hit", tableLimitHit); err != nil {
+		return err
+	}
+	return d.Set("tables", vs)
+}
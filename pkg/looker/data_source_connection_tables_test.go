@@ -0,0 +1,46 @@
+package looker
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDsReadConnectionTables(t *testing.T) {
+	var gotQuery map[string]string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = map[string]string{
+			"schema_name": r.URL.Query().Get("schema_name"),
+			"table_limit": r.URL.Query().Get("table_limit"),
+			"cache":       r.URL.Query().Get("cache"),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{
+			"name": "public",
+			"table_limit_hit": true,
+			"tables": [
+				{"name": "orders", "sql_escaped_name": "\"orders\"", "schema_name": "public", "rows": 42},
+				{"name": "users"}
+			]
+		}]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dsConnectionTablesSchema, map[string]interface{}{
+		"connection_name": "warehouse",
+		"schema_name":     "public",
+		"table_limit":     2,
+	})
+
+	err := dsReadConnectionTables(d, client)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"schema_name": "public", "table_limit": "2", "cache": "true"}, gotQuery)
+	assert.Equal(t, true, d.Get("table_limit_hit"))
+	assert.Equal(t, 2, d.Get("tables.#"))
+	assert.Equal(t, "orders", d.Get("tables.0.name"))
+	assert.Equal(t, 42, d.Get("tables.0.rows"))
+	// tables without a schema name are reported in the schema they're listed in
+	assert.Equal(t, "public", d.Get("tables.1.schema_name"))
+}
@@ -0,0 +1,90 @@
+package looker
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsConnectionTestSchema = map[string]*schema.Schema{
+	"connection_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"tests": {
+		Type:        schema.TypeList,
+		Description: "Tests to run. Defaults to every test supported by the dialect of the connection.",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(connectionTests, false),
+		},
+	},
+	"success": {
+		Type:        schema.TypeBool,
+		Description: "Whether none of the tests returned an error.",
+		Computed:    true,
+	},
+	"results": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Description: "Result of the test, e.g. `success`, `error` or `skipped`.",
+					Computed:    true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func dsConnectionTest() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadConnectionTest,
+		Schema: dsConnectionTestSchema,
+	}
+}
+
+func dsReadConnectionTest(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	connectionName := d.Get("connection_name").(string)
+	tests := expandStringList(d.Get("tests"))
+
+	results, err := testConnection(client, connectionName, tests)
+	if err != nil {
+		return err
+	}
+
+	success := true
+	vs := make([]interface{}, 0, len(results))
+	for _, result := range results {
+		status := derefString(result.Status)
+		if status == "error" {
+			success = false
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":    derefString(result.Name),
+			"status":  status,
+			"message": derefString(result.Message),
+		})
+	}
+
+	d.SetId(connectionName + ":" + strings.Join(tests, ","))
+	if err = d.Set("success", success); err != nil {
+		return err
+	}
+	return d.Set("results", vs)
+}
@@ -0,0 +1,49 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_dsConnectionTest(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: dsConnectionTestConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_connection_test.test", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.looker_connection_test.test", "results.0.name", "connect"),
+					// the test connection uses a dummy service account
+					resource.TestCheckResourceAttr("data.looker_connection_test.test", "success", "false"),
+				),
+			},
+		},
+	})
+}
+
+func dsConnectionTestConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "looker_connection" "connection_test_test" {
+		name         = "%s"
+		host         = "test_project"
+		username     = "test@testproject.iam.gserviceaccount.com"
+		certificate  = filebase64("testdata/gcp-sa.json")
+		file_type    = ".json"
+		database     = "test_dataset"
+		tmp_db_name  = "tmp_test_dataset"
+		dialect_name = "bigquery_standard_sql"
+	}
+	data "looker_connection_test" "test" {
+		connection_name = looker_connection.connection_test_test.name
+		tests           = ["connect"]
+	}
+	`, name)
+}
@@ -0,0 +1,99 @@
+package looker
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsDatagroupsSchema = map[string]*schema.Schema{
+	"model_name": {
+		Type:        schema.TypeString,
+		Description: "Only return the datagroups of this model.",
+		Optional:    true,
+	},
+	"datagroups": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"model_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"stale_before": {
+					Type:        schema.TypeInt,
+					Description: "UNIX timestamp before which cache entries are considered stale.",
+					Computed:    true,
+				},
+				"triggered_at": {
+					Type:        schema.TypeInt,
+					Description: "UNIX timestamp at which the datagroup was last triggered.",
+					Computed:    true,
+				},
+				"trigger_check_at": {
+					Type:        schema.TypeInt,
+					Description: "UNIX timestamp at which the trigger was last checked.",
+					Computed:    true,
+				},
+				"trigger_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"trigger_error": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func dsDatagroups() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadDatagroups,
+		Schema: dsDatagroupsSchema,
+	}
+}
+
+func dsReadDatagroups(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	modelName := d.Get("model_name").(string)
+
+	datagroups, err := client.AllDatagroups(nil)
+	if err != nil {
+		return err
+	}
+
+	vs := make([]interface{}, 0, len(datagroups))
+	for _, datagroup := range datagroups {
+		if modelName != "" && derefString(datagroup.ModelName) != modelName {
+			continue
+		}
+		vs = append(vs, map[string]interface{}{
+			"id":               derefString(datagroup.Id),
+			"model_name":       derefString(datagroup.ModelName),
+			"name":             derefString(datagroup.Name),
+			"stale_before":     derefInt64(datagroup.StaleBefore),
+			"triggered_at":     derefInt64(datagroup.TriggeredAt),
+			"trigger_check_at": derefInt64(datagroup.TriggerCheckAt),
+			"trigger_value":    derefString(datagroup.TriggerValue),
+			"trigger_error":    derefString(datagroup.TriggerError),
+		})
+	}
+
+	if modelName == "" {
+		d.SetId("all")
+	} else {
+		d.SetId(modelName)
+	}
+	return d.Set("datagroups", vs)
+}
@@ -0,0 +1,31 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_dsDatagroups(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: dsDatagroupsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.looker_datagroups.test", "datagroups.#"),
+					resource.TestCheckResourceAttr("data.looker_datagroups.test", "datagroups.0.model_name", "system__activity"),
+				),
+			},
+		},
+	})
+}
+
+func dsDatagroupsConfig() string {
+	return `
+	data "looker_datagroups" "test" {
+		model_name = "system__activity"
+	}
+	`
+}
@@ -0,0 +1,144 @@
+package looker
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsDialectsSchema = map[string]*schema.Schema{
+	"dialects": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the dialect, as used in the `dialect_name` of a `looker_connection`.",
+					Computed:    true,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"label_for_database_equivalent": {
+					Type:        schema.TypeString,
+					Description: "What the dialect calls a database, e.g. `Dataset` for BigQuery.",
+					Computed:    true,
+				},
+				"default_port": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"default_max_connections": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"installed": {
+					Type:        schema.TypeBool,
+					Description: "Whether the driver of the dialect is installed.",
+					Computed:    true,
+				},
+				"supported_options": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"additional_params": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"auth": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"host": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"oauth_credentials": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"project_name": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"schema": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"ssl": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"timezone": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"tmp_table": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+							"username_required": {
+								Type:     schema.TypeBool,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func dsDialects() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadDialects,
+		Schema: dsDialectsSchema,
+	}
+}
+
+func dsReadDialects(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	dialects, err := client.AllDialectInfos("", nil)
+	if err != nil {
+		return err
+	}
+
+	vs := make([]interface{}, 0, len(dialects))
+	for _, dialect := range dialects {
+		vs = append(vs, map[string]interface{}{
+			"name":                          derefString(dialect.Name),
+			"label":                         derefString(dialect.Label),
+			"label_for_database_equivalent": derefString(dialect.LabelForDatabaseEquivalent),
+			"default_port":                  derefString(dialect.DefaultPort),
+			"default_max_connections":       derefString(dialect.DefaultMaxConnections),
+			"installed":                     derefBool(dialect.Installed),
+			"supported_options":             flattenDialectInfoOptions(dialect.SupportedOptions),
+		})
+	}
+
+	d.SetId("all")
+	return d.Set("dialects", vs)
+}
+
+func flattenDialectInfoOptions(options *apiclient.DialectInfoOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"additional_params": derefBool(options.AdditionalParams),
+			"auth":              derefBool(options.Auth),
+			"host":              derefBool(options.Host),
+			"oauth_credentials": derefBool(options.OauthCredentials),
+			"project_name":      derefBool(options.ProjectName),
+			"schema":            derefBool(options.Schema),
+			"ssl":               derefBool(options.Ssl),
+			"timezone":          derefBool(options.Timezone),
+			"tmp_table":         derefBool(options.TmpTable),
+			"username_required": derefBool(options.UsernameRequired),
+		},
+	}
+}
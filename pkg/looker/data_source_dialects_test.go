@@ -0,0 +1,29 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_dsDialects(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: dsDialectsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.looker_dialects.test", "dialects.#"),
+					resource.TestCheckResourceAttrSet("data.looker_dialects.test", "dialects.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func dsDialectsConfig() string {
+	return `
+	data "looker_dialects" "test" {}
+	`
+}
@@ -0,0 +1,178 @@
+package looker
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsLookmlModelExploreFieldSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"label": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"label_short": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"description": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"view": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"sql": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"tags": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	},
+	"hidden": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+}
+
+var dsLookmlModelExploreSchema = map[string]*schema.Schema{
+	"model_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"explore_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"label": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"description": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"connection_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"dimensions": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Resource{Schema: dsLookmlModelExploreFieldSchema},
+		Computed: true,
+	},
+	"measures": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Resource{Schema: dsLookmlModelExploreFieldSchema},
+		Computed: true,
+	},
+	"filters": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Resource{Schema: dsLookmlModelExploreFieldSchema},
+		Computed: true,
+	},
+	"parameters": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Resource{Schema: dsLookmlModelExploreFieldSchema},
+		Computed: true,
+	},
+}
+
+func dsLookmlModelExplore() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadLookmlModelExplore,
+		Schema: dsLookmlModelExploreSchema,
+	}
+}
+
+func dsReadLookmlModelExplore(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	modelName := d.Get("model_name").(string)
+	exploreName := d.Get("explore_name").(string)
+
+	explore, err := client.LookmlModelExplore(modelName, exploreName, "", nil)
+	if err != nil {
+		return err
+	}
+
+	if err = d.Set("label", explore.Label); err != nil {
+		return err
+	}
+	if err = d.Set("description", explore.Description); err != nil {
+		return err
+	}
+	if err = d.Set("connection_name", explore.ConnectionName); err != nil {
+		return err
+	}
+
+	var fieldset apiclient.LookmlModelExploreFieldset
+	if explore.Fields != nil {
+		fieldset = *explore.Fields
+	}
+	if err = d.Set("dimensions", flattenLookmlModelExploreFields(fieldset.Dimensions)); err != nil {
+		return err
+	}
+	if err = d.Set("measures", flattenLookmlModelExploreFields(fieldset.Measures)); err != nil {
+		return err
+	}
+	if err = d.Set("filters", flattenLookmlModelExploreFields(fieldset.Filters)); err != nil {
+		return err
+	}
+	if err = d.Set("parameters", flattenLookmlModelExploreFields(fieldset.Parameters)); err != nil {
+		return err
+	}
+
+	d.SetId(buildTwoPartID(&modelName, &exploreName))
+	return nil
+}
+
+func flattenLookmlModelExploreFields(fields *[]apiclient.LookmlModelExploreField) []interface{} {
+	if fields == nil {
+		return []interface{}{}
+	}
+
+	vs := make([]interface{}, 0, len(*fields))
+	for _, field := range *fields {
+		v := make(map[string]interface{})
+		if field.Name != nil {
+			v["name"] = *field.Name
+		}
+		if field.Label != nil {
+			v["label"] = *field.Label
+		}
+		if field.LabelShort != nil {
+			v["label_short"] = *field.LabelShort
+		}
+		if field.Description != nil {
+			v["description"] = *field.Description
+		}
+		if field.Type != nil {
+			v["type"] = *field.Type
+		}
+		if field.View != nil {
+			v["view"] = *field.View
+		}
+		if field.Sql != nil {
+			v["sql"] = *field.Sql
+		}
+		if field.Tags != nil {
+			v["tags"] = flattenStringList(*field.Tags)
+		}
+		if field.Hidden != nil {
+			v["hidden"] = *field.Hidden
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
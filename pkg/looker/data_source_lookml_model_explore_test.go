@@ -0,0 +1,33 @@
+package looker
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_dsLookmlModelExplore(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: dsLookmlModelExploreConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_lookml_model_explore.test", "id", "system__activity:history"),
+					resource.TestCheckResourceAttrSet("data.looker_lookml_model_explore.test", "dimensions.0.name"),
+					resource.TestCheckResourceAttrSet("data.looker_lookml_model_explore.test", "measures.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func dsLookmlModelExploreConfig() string {
+	return `
+	data "looker_lookml_model_explore" "test" {
+		model_name   = "system__activity"
+		explore_name = "history"
+	}
+	`
+}
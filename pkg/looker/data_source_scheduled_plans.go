@@ -0,0 +1,212 @@
+package looker
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var dsScheduledPlansSchema = map[string]*schema.Schema{
+	"user_id": {
+		Type:        schema.TypeString,
+		Description: "Only return scheduled plans owned by this user.",
+		Optional:    true,
+	},
+	"dashboard_id": {
+		Type:          schema.TypeString,
+		Description:   "Only return scheduled plans for this dashboard.",
+		Optional:      true,
+		ConflictsWith: []string{"look_id"},
+	},
+	"look_id": {
+		Type:          schema.TypeString,
+		Description:   "Only return scheduled plans for this look.",
+		Optional:      true,
+		ConflictsWith: []string{"dashboard_id"},
+	},
+	"destination_domain": {
+		Type: schema.TypeString,
+		Description: "Only return scheduled plans with at least one destination in this domain (or one of its " +
+			"subdomains), e.g. `example.com` matches `user@example.com` and `https://hooks.example.com/path`.",
+		Optional: true,
+	},
+	"scheduled_plans": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"user_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"dashboard_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"look_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"lookml_dashboard_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"crontab": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"datagroup": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"recipients": {
+					Type:     schema.TypeList,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				"destination": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"address": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"format": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func dsScheduledPlans() *schema.Resource {
+	return &schema.Resource{
+		Read:   dsReadScheduledPlans,
+		Schema: dsScheduledPlansSchema,
+	}
+}
+
+func dsReadScheduledPlans(d *schema.ResourceData, m interface{}) error {
+	client := m.(*apiclient.LookerSDK)
+
+	userID := d.Get("user_id").(string)
+	dashboardID := d.Get("dashboard_id").(string)
+	lookID := d.Get("look_id").(string)
+	domain := d.Get("destination_domain").(string)
+
+	// without a user_id, list the plans of every user rather than the API user's
+	allUsers := userID == ""
+	var requestUserID *string
+	if userID != "" {
+		requestUserID = &userID
+	}
+
+	var scheduledPlans []apiclient.ScheduledPlan
+	var err error
+	switch {
+	case dashboardID != "":
+		request := apiclient.RequestScheduledPlansForDashboard{DashboardId: dashboardID, UserId: requestUserID, AllUsers: &allUsers}
+		scheduledPlans, err = client.ScheduledPlansForDashboard(request, nil)
+	case lookID != "":
+		request := apiclient.RequestScheduledPlansForLook{LookId: lookID, UserId: requestUserID, AllUsers: &allUsers}
+		scheduledPlans, err = client.ScheduledPlansForLook(request, nil)
+	default:
+		request := apiclient.RequestAllScheduledPlans{UserId: requestUserID, AllUsers: &allUsers}
+		scheduledPlans, err = client.AllScheduledPlans(request, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	vs := make([]interface{}, 0, len(scheduledPlans))
+	for _, scheduledPlan := range scheduledPlans {
+		var destinations []apiclient.ScheduledPlanDestination
+		if scheduledPlan.ScheduledPlanDestination != nil {
+			destinations = *scheduledPlan.ScheduledPlanDestination
+		}
+
+		if domain != "" && !scheduledPlanHasDestinationInDomain(destinations, domain) {
+			continue
+		}
+
+		recipients := make([]interface{}, 0, len(destinations))
+		destinationList := make([]interface{}, 0, len(destinations))
+		for _, destination := range destinations {
+			recipients = append(recipients, derefString(destination.Address))
+			destinationList = append(destinationList, map[string]interface{}{
+				"type":    derefString(destination.Type),
+				"address": derefString(destination.Address),
+				"format":  derefString(destination.Format),
+			})
+		}
+
+		vs = append(vs, map[string]interface{}{
+			"id":                  derefString(scheduledPlan.Id),
+			"name":                derefString(scheduledPlan.Name),
+			"user_id":             derefString(scheduledPlan.UserId),
+			"enabled":             derefBool(scheduledPlan.Enabled),
+			"dashboard_id":        derefString(scheduledPlan.DashboardId),
+			"look_id":             derefString(scheduledPlan.LookId),
+			"lookml_dashboard_id": derefString(scheduledPlan.LookmlDashboardId),
+			"crontab":             derefString(scheduledPlan.Crontab),
+			"datagroup":           derefString(scheduledPlan.Datagroup),
+			"recipients":          recipients,
+			"destination":         destinationList,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s:%s", userID, dashboardID, lookID, domain))
+	return d.Set("scheduled_plans", vs)
+}
+
+func scheduledPlanHasDestinationInDomain(destinations []apiclient.ScheduledPlanDestination, domain string) bool {
+	domain = strings.ToLower(domain)
+	for _, destination := range destinations {
+		destinationDomain := scheduledPlanDestinationDomain(derefString(destination.Address))
+		if destinationDomain == domain || strings.HasSuffix(destinationDomain, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduledPlanDestinationDomain returns the domain of an email address or of
+// the host of a webhook, S3 or SFTP URL.
+func scheduledPlanDestinationDomain(address string) string {
+	if strings.Contains(address, "://") {
+		u, err := url.Parse(address)
+		if err != nil {
+			return ""
+		}
+		return strings.ToLower(u.Hostname())
+	}
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return strings.ToLower(address[i+1:])
+	}
+	return strings.ToLower(address)
+}
@@ -0,0 +1,88 @@
+package looker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_dsScheduledPlans(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: dsScheduledPlansConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_scheduled_plans.test", "scheduled_plans.#", "1"),
+					resource.TestCheckResourceAttr("data.looker_scheduled_plans.test", "scheduled_plans.0.name", name),
+					resource.TestCheckResourceAttr("data.looker_scheduled_plans.test", "scheduled_plans.0.recipients.0", "test@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduledPlanDestinationDomain(t *testing.T) {
+	tests := map[string]struct {
+		address string
+		wantRes string
+	}{
+		"email": {
+			address: "User@Example.com",
+			wantRes: "example.com",
+		},
+		"webhook": {
+			address: "https://hooks.example.com:8443/services/abc",
+			wantRes: "hooks.example.com",
+		},
+		"s3": {
+			address: "s3://example-reports/weekly",
+			wantRes: "example-reports",
+		},
+		"sftp with user": {
+			address: "sftp://looker@files.example.com/upload",
+			wantRes: "files.example.com",
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			actual := scheduledPlanDestinationDomain(tt.address)
+			assert.Equal(t, tt.wantRes, actual)
+		})
+	}
+}
+
+func dsScheduledPlansConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "looker_look" "scheduled_plans_test" {
+		title     = "%s"
+		folder_id = "1"
+		query {
+			model  = "system__activity"
+			view   = "user"
+			fields = ["user.count"]
+		}
+	}
+	resource "looker_scheduled_plan" "scheduled_plans_test" {
+		name    = "%s"
+		look_id = looker_look.scheduled_plans_test.id
+		crontab = "0 6 * * 1"
+		destination {
+			type    = "email"
+			address = "test@example.com"
+			format  = "csv"
+		}
+	}
+	data "looker_scheduled_plans" "test" {
+		look_id            = looker_scheduled_plan.scheduled_plans_test.look_id
+		destination_domain = "example.com"
+	}
+	`, name, name)
+}
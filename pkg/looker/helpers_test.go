@@ -1,6 +1,21 @@
 package looker
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
 
 func providers() map[string]*schema.Provider {
 	p := Provider()
@@ -8,3 +23,158 @@ func providers() map[string]*schema.Provider {
 		"looker": p,
 	}
 }
+
+// newTestClient returns a client for a mock Looker API. The mock answers the
+// login endpoint itself and passes every other request to handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *apiclient.LookerSDK {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/4.0/login" {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return apiclient.NewLookerSDK(rtl.NewAuthSession(rtl.ApiSettings{
+		BaseUrl:      server.URL,
+		ApiVersion:   "4.0",
+		ClientId:     "client_id",
+		ClientSecret: "client_secret",
+	}))
+}
+
+// mockSettingsAPI serves a configuration Looker only has one of, or a single
+// object, merging creations and updates into it and recording their bodies
+// and the calls made to it.
+type mockSettingsAPI struct {
+	config  map[string]interface{}
+	updates []map[string]interface{}
+	calls   []string
+
+	// roleIDs are the IDs of the roles the API lists.
+	roleIDs []string
+	// writeOnly are the keys, like secrets, that are saved but never returned.
+	writeOnly []string
+	// idLists maps lists of IDs to the lists of objects the API returns
+	// instead, e.g. default_new_user_role_ids to default_new_user_roles.
+	idLists map[string]string
+	// tests are the responses to the calls, like "PUT /api/4.0/ldap_config/test_auth",
+	// that test a configuration without saving it, and testBodies the bodies
+	// of those calls. An error response rejects the configuration.
+	tests      map[string]interface{}
+	testBodies []map[string]interface{}
+}
+
+func (api *mockSettingsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	call := r.Method + " " + r.URL.Path
+	api.calls = append(api.calls, call)
+
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if response, ok := api.tests[call]; ok {
+		api.testBodies = append(api.testBodies, body)
+		if err, ok := response.(error); ok {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			response = map[string]interface{}{"message": err.Error()}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if r.URL.Path == "/api/4.0/roles" {
+		roles := make([]map[string]interface{}, 0, len(api.roleIDs))
+		for _, id := range api.roleIDs {
+			roles = append(roles, map[string]interface{}{"id": id})
+		}
+		_ = json.NewEncoder(w).Encode(roles)
+		return
+	}
+
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		api.updates = append(api.updates, body)
+		for k, v := range body {
+			api.config[k] = v
+		}
+		for _, k := range api.writeOnly {
+			delete(api.config, k)
+		}
+		for k, objects := range api.idLists {
+			if ids, ok := body[k].([]interface{}); ok {
+				vs := make([]interface{}, 0, len(ids))
+				for _, id := range ids {
+					vs = append(vs, map[string]interface{}{"id": id})
+				}
+				api.config[objects] = vs
+				delete(api.config, k)
+			}
+		}
+	}
+
+	_ = json.NewEncoder(w).Encode(api.config)
+}
+
+// applyTestResourceChange plans and applies raw as the configuration of r,
+// the way Terraform does it, against the prior state (nil when creating).
+func applyTestResourceChange(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff == nil {
+		return state, nil
+	}
+	diff.RawConfig = testConfigValue(r.CoreConfigSchema().ImpliedType(), raw)
+
+	return r.Apply(ctx, state, diff, meta)
+}
+
+// testConfigValue converts a raw configuration value to the given type.
+func testConfigValue(ty cty.Type, v interface{}) cty.Value {
+	if v == nil {
+		return cty.NullVal(ty)
+	}
+
+	switch {
+	case ty.IsObjectType():
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return cty.NullVal(ty)
+		}
+		attrs := make(map[string]cty.Value)
+		for name, attrType := range ty.AttributeTypes() {
+			attrs[name] = testConfigValue(attrType, m[name])
+		}
+		return cty.ObjectVal(attrs)
+	case ty.IsListType() || ty.IsSetType():
+		l := v.([]interface{})
+		if len(l) == 0 {
+			return cty.NullVal(ty)
+		}
+		vals := make([]cty.Value, 0, len(l))
+		for _, e := range l {
+			vals = append(vals, testConfigValue(ty.ElementType(), e))
+		}
+		if ty.IsSetType() {
+			return cty.SetVal(vals)
+		}
+		return cty.ListVal(vals)
+	case ty == cty.Bool:
+		return cty.BoolVal(v.(bool))
+	case ty == cty.Number:
+		return cty.NumberIntVal(int64(v.(int)))
+	default:
+		return cty.StringVal(fmt.Sprintf("%v", v))
+	}
+}
@@ -64,9 +64,35 @@ func Provider() *schema.Provider {
 			"looker_user_attribute_group_value": resourceUserAttributeGroupValue(),
 			"looker_connection":                 resourceConnection(),
 			"looker_lookml_model":               resourceLookMLModel(),
+			"looker_dashboard":                  resourceDashboard(),
+			"looker_lookml_dashboard_import":    resourceLookmlDashboardImport(),
+			"looker_look":                       resourceLook(),
+			"looker_scheduled_plan":             resourceScheduledPlan(),
+			"looker_alert":                      resourceAlert(),
+			"looker_board":                      resourceBoard(),
+			"looker_homepage":                   resourceHomepage(),
+			"looker_datagroup_trigger":          resourceDatagroupTrigger(),
+			"looker_derived_table_rebuild":      resourceDerivedTableRebuild(),
+			"looker_ssh_server":                 resourceSshServer(),
+			"looker_ssh_tunnel":                 resourceSshTunnel(),
+			"looker_oauth_client_app":           resourceOauthClientApp(),
+			"looker_external_oauth_application": resourceExternalOauthApplication(),
+			"looker_saml_config":                resourceSamlConfig(),
+			"looker_oidc_config":                resourceOidcConfig(),
+			"looker_ldap_config":                resourceLdapConfig(),
+			"looker_password_config":            resourcePasswordConfig(),
+			"looker_session_config":             resourceSessionConfig(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"looker_role_users": dsRoleUsers(),
+			"looker_role_users":           dsRoleUsers(),
+			"looker_lookml_model_explore": dsLookmlModelExplore(),
+			"looker_scheduled_plans":      dsScheduledPlans(),
+			"looker_datagroups":           dsDatagroups(),
+			"looker_connection_test":      dsConnectionTest(),
+			"looker_dialects":             dsDialects(),
+			"looker_connection_schemas":   dsConnectionSchemas(),
+			"looker_connection_tables":    dsConnectionTables(),
+			"looker_connection_columns":   dsConnectionColumns(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
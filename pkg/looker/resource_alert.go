@@ -0,0 +1,299 @@
+package looker
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAlertCreate,
+		ReadContext:   resourceAlertRead,
+		UpdateContext: resourceAlertUpdate,
+		DeleteContext: resourceAlertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_element_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the dashboard element (tile) the alert checks, e.g. one of the `element_ids` of a `looker_dashboard`.",
+				Required:    true,
+			},
+			"field": {
+				Type:        schema.TypeString,
+				Description: "Name of the field the alert checks, in the form `view.field`.",
+				Required:    true,
+			},
+			"field_title": {
+				Type:        schema.TypeString,
+				Description: "Title of the field. Defaults to the field name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"comparison_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apiclient.ComparisonType_EQUAL_TO),
+					string(apiclient.ComparisonType_GREATER_THAN),
+					string(apiclient.ComparisonType_GREATER_THAN_OR_EQUAL_TO),
+					string(apiclient.ComparisonType_LESS_THAN),
+					string(apiclient.ComparisonType_LESS_THAN_OR_EQUAL_TO),
+					string(apiclient.ComparisonType_INCREASES_BY),
+					string(apiclient.ComparisonType_DECREASES_BY),
+					string(apiclient.ComparisonType_CHANGES_BY),
+				}, false),
+			},
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"cron": {
+				Type:        schema.TypeString,
+				Description: "Vixie-style crontab specifying when to check the alert. Must be at least 15 minutes apart.",
+				Required:    true,
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the user owning the alert.",
+				Required:    true,
+			},
+			"custom_title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination": {
+				Type:        schema.TypeList,
+				Description: "Where to send the alert. All destinations must be of the same type.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(apiclient.DestinationType_EMAIL),
+								string(apiclient.DestinationType_ACTION_HUB),
+							}, false),
+						},
+						"email_address": {
+							Type:        schema.TypeString,
+							Description: "Email address for `EMAIL` destinations.",
+							Optional:    true,
+						},
+						"action_hub_integration_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the Action Hub integration for `ACTION_HUB` destinations.",
+							Optional:    true,
+						},
+						"action_hub_form_params_json": {
+							Type:             schema.TypeString,
+							Description:      "JSON object with the Action Hub form parameters for `ACTION_HUB` destinations.",
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlertCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	body := expandWriteAlert(d)
+
+	log.Printf("[DEBUG] Create alert on dashboard element %s", *body.DashboardElementId)
+
+	alert, err := client.CreateAlert(body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*alert.Id)
+
+	return resourceAlertRead(ctx, d, m)
+}
+
+func resourceAlertRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	alert, err := client.GetAlert(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("dashboard_element_id", alert.DashboardElementId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("field", alert.Field.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("field_title", alert.Field.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("comparison_type", string(alert.ComparisonType)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("threshold", alert.Threshold); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("cron", alert.Cron); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("owner_id", alert.OwnerId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("custom_title", alert.CustomTitle); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", alert.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_public", alert.IsPublic); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_disabled", alert.IsDisabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("disabled_reason", alert.DisabledReason); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("destination", flattenAlertDestinations(alert.Destinations)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAlertUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	alertID := d.Id()
+	body := expandWriteAlert(d)
+
+	log.Printf("[DEBUG] Update alert %s", alertID)
+
+	_, err := client.UpdateAlert(alertID, body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAlertRead(ctx, d, m)
+}
+
+func resourceAlertDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	alertID := d.Id()
+
+	log.Printf("[DEBUG] Delete alert %s", alertID)
+
+	err := client.DeleteAlert(alertID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandWriteAlert(d *schema.ResourceData) apiclient.WriteAlert {
+	dashboardElementID := d.Get("dashboard_element_id").(string)
+	fieldName := d.Get("field").(string)
+	fieldTitle := d.Get("field_title").(string)
+	if fieldTitle == "" {
+		fieldTitle = fieldName
+	}
+	customTitle := d.Get("custom_title").(string)
+	description := d.Get("description").(string)
+	isPublic := d.Get("is_public").(bool)
+	isDisabled := d.Get("is_disabled").(bool)
+
+	body := apiclient.WriteAlert{
+		DashboardElementId: &dashboardElementID,
+		Field: apiclient.AlertField{
+			Name:  fieldName,
+			Title: fieldTitle,
+		},
+		ComparisonType: apiclient.ComparisonType(d.Get("comparison_type").(string)),
+		Threshold:      d.Get("threshold").(float64),
+		Cron:           d.Get("cron").(string),
+		OwnerId:        d.Get("owner_id").(string),
+		CustomTitle:    &customTitle,
+		Description:    &description,
+		IsPublic:       &isPublic,
+		IsDisabled:     &isDisabled,
+	}
+
+	// the reason is only sent when set, or cleared when it's removed
+	if v, ok := d.GetOk("disabled_reason"); ok || d.HasChange("disabled_reason") {
+		disabledReason := v.(string)
+		body.DisabledReason = &disabledReason
+	}
+
+	destinations := make([]apiclient.AlertDestination, 0)
+	for _, v := range d.Get("destination").([]interface{}) {
+		raw := v.(map[string]interface{})
+		destination := apiclient.AlertDestination{
+			DestinationType: apiclient.DestinationType(raw["type"].(string)),
+		}
+		if emailAddress := raw["email_address"].(string); emailAddress != "" {
+			destination.EmailAddress = &emailAddress
+		}
+		if integrationID := raw["action_hub_integration_id"].(string); integrationID != "" {
+			destination.ActionHubIntegrationId = &integrationID
+		}
+		if formParams := raw["action_hub_form_params_json"].(string); formParams != "" {
+			destination.ActionHubFormParamsJson = &formParams
+		}
+		destinations = append(destinations, destination)
+	}
+	body.Destinations = destinations
+
+	return body
+}
+
+func flattenAlertDestinations(destinations []apiclient.AlertDestination) []interface{} {
+	vs := make([]interface{}, 0, len(destinations))
+	for _, destination := range destinations {
+		vs = append(vs, map[string]interface{}{
+			"type":                        string(destination.DestinationType),
+			"email_address":               derefString(destination.EmailAddress),
+			"action_hub_integration_id":   derefString(destination.ActionHubIntegrationId),
+			"action_hub_form_params_json": derefString(destination.ActionHubFormParamsJson),
+		})
+	}
+	return vs
+}
@@ -0,0 +1,147 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Alert(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: alertConfig(name, "GREATER_THAN", 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("looker_alert.test", "dashboard_element_id", "looker_dashboard.alert_test", "element_ids.0"),
+					resource.TestCheckResourceAttrPair("looker_alert.test", "owner_id", "looker_user.alert_test", "id"),
+					resource.TestCheckResourceAttr("looker_alert.test", "field", "user.count"),
+					resource.TestCheckResourceAttr("looker_alert.test", "comparison_type", "GREATER_THAN"),
+					resource.TestCheckResourceAttr("looker_alert.test", "threshold", "100"),
+					resource.TestCheckResourceAttr("looker_alert.test", "destination.0.email_address", fmt.Sprintf("%s@example.com", name)),
+				),
+			},
+			{
+				Config: alertConfig(name, "LESS_THAN", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_alert.test", "comparison_type", "LESS_THAN"),
+					resource.TestCheckResourceAttr("looker_alert.test", "threshold", "10"),
+				),
+			},
+			{
+				ResourceName:      "looker_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckAlertDestroy,
+	})
+}
+
+func TestAlertDisabledReason(t *testing.T) {
+	api := &mockSettingsAPI{config: map[string]interface{}{"id": "1"}}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"dashboard_element_id": "2",
+		"field":                "user.count",
+		"comparison_type":      "GREATER_THAN",
+		"threshold":            100,
+		"cron":                 "0 6 * * *",
+		"owner_id":             "3",
+		"is_disabled":          true,
+		"disabled_reason":      "Maintenance",
+		"destination": []interface{}{
+			map[string]interface{}{
+				"type":          "EMAIL",
+				"email_address": "test@example.com",
+			},
+		},
+	}
+
+	state, diags := applyTestResourceChange(t, resourceAlert(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "Maintenance", api.updates[0]["disabled_reason"])
+
+	// removing the reason clears it
+	config["is_disabled"] = false
+	delete(config, "disabled_reason")
+
+	state, diags = applyTestResourceChange(t, resourceAlert(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "", api.updates[1]["disabled_reason"])
+	assert.Equal(t, "", state.Attributes["disabled_reason"])
+
+	diff, err := resourceAlert().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+}
+
+func testAccCheckAlertDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_alert" {
+			continue
+		}
+
+		_, err := client.GetAlert(rs.Primary.ID, nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("alert still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func alertConfig(name, comparisonType string, threshold int) string {
+	return fmt.Sprintf(`
+	resource "looker_user" "alert_test" {
+		first_name = "%s"
+		last_name  = "%s"
+		email      = "%s@example.com"
+	}
+	resource "looker_dashboard" "alert_test" {
+		title     = "%s"
+		folder_id = "1"
+		definition = jsonencode({
+			elements = [{
+				title = "Users"
+				type  = "vis"
+				query = {
+					model  = "system__activity"
+					view   = "user"
+					fields = ["user.count"]
+				}
+			}]
+		})
+	}
+	resource "looker_alert" "test" {
+		dashboard_element_id = looker_dashboard.alert_test.element_ids[0]
+		field                = "user.count"
+		comparison_type      = "%s"
+		threshold            = %d
+		cron                 = "0 * * * *"
+		owner_id             = looker_user.alert_test.id
+		destination {
+			type          = "EMAIL"
+			email_address = looker_user.alert_test.email
+		}
+	}
+	`, name, name, name, name, comparisonType, threshold)
+}
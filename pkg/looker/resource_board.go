@@ -0,0 +1,471 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceBoard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBoardCreate,
+		ReadContext:   resourceBoardRead,
+		UpdateContext: resourceBoardUpdate,
+		DeleteContext: resourceBoardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceBoardCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"section": {
+				Type: schema.TypeList,
+				Description: "Sections of the board, in display order. Sections and their items are matched " +
+					"with the existing ones by position, so reordering them updates them in place.",
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"item": {
+							Type:        schema.TypeList,
+							Description: "Items of the section, in display order. Each item references exactly one of `dashboard_id`, `look_id`, `lookml_dashboard_id` or `url`.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dashboard_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"look_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"lookml_dashboard_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:        schema.TypeString,
+										Description: "Title shown instead of the title of the referenced content.",
+										Optional:    true,
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Description: "Description shown instead of the description of the referenced content.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBoardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	body := expandWriteBoard(d)
+
+	log.Printf("[DEBUG] Create board %s", *body.Title)
+
+	board, err := client.CreateBoard(body, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*board.Id)
+
+	if err = syncBoardSections(client, board, d.Get("section").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceBoardRead(ctx, d, m)
+}
+
+func resourceBoardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	board, err := client.Board(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("title", board.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", board.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("section", flattenBoardSections(board)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceBoardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	boardID := d.Id()
+
+	log.Printf("[DEBUG] Update board %s", boardID)
+
+	board, err := client.UpdateBoard(boardID, expandWriteBoard(d), "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("section") {
+		if err = syncBoardSections(client, board, d.Get("section").([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceBoardRead(ctx, d, m)
+}
+
+func resourceBoardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	boardID := d.Id()
+
+	log.Printf("[DEBUG] Delete board %s", boardID)
+
+	_, err := client.DeleteBoard(boardID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// boardItemReferences are the attributes of a board item referencing its
+// content, of which exactly one must be set.
+var boardItemReferences = []string{"dashboard_id", "look_id", "lookml_dashboard_id", "url"}
+
+// resourceBoardCustomizeDiff checks that every item references exactly one
+// piece of content, so that mistakes fail at plan time rather than halfway
+// through syncing the sections. Items with references only known after apply
+// aren't checked.
+func resourceBoardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	return validateBoardItems(d)
+}
+
+func validateBoardItems(d interface {
+	Get(string) interface{}
+	NewValueKnown(string) bool
+}) error {
+	if !d.NewValueKnown("section") {
+		return nil
+	}
+
+	for i, section := range d.Get("section").([]interface{}) {
+		rawSection, ok := section.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for j, item := range rawSection["item"].([]interface{}) {
+			rawItem, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			references, known := 0, true
+			for _, key := range boardItemReferences {
+				if !d.NewValueKnown(fmt.Sprintf("section.%d.item.%d.%s", i, j, key)) {
+					known = false
+					break
+				}
+				if rawItem[key].(string) != "" {
+					references++
+				}
+			}
+			if known && references != 1 {
+				return fmt.Errorf("section.%d.item.%d must set exactly one of %s", i, j, strings.Join(boardItemReferences, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandWriteBoard(d *schema.ResourceData) apiclient.WriteBoard {
+	title := d.Get("title").(string)
+	description := d.Get("description").(string)
+
+	return apiclient.WriteBoard{
+		Title:       &title,
+		Description: &description,
+	}
+}
+
+// syncBoardSections makes the sections of a board match the configured ones.
+// Existing sections are updated by position, missing ones are created and
+// extra ones are deleted, then the section order of the board is set.
+func syncBoardSections(client *apiclient.LookerSDK, board apiclient.Board, sections []interface{}) error {
+	existingSections := orderedBoardSections(board)
+
+	sectionOrder := make([]string, 0, len(sections))
+	for i, v := range sections {
+		raw := v.(map[string]interface{})
+		title := raw["title"].(string)
+		description := raw["description"].(string)
+		body := apiclient.WriteBoardSection{
+			Title:       &title,
+			Description: &description,
+		}
+
+		var section apiclient.BoardSection
+		if i < len(existingSections) {
+			section = existingSections[i]
+			log.Printf("[DEBUG] Update board section %s", *section.Id)
+			if _, err := client.UpdateBoardSection(*section.Id, body, "", nil); err != nil {
+				return err
+			}
+		} else {
+			body.BoardId = board.Id
+			log.Printf("[DEBUG] Create board section %s on board %s", title, *board.Id)
+			var err error
+			section, err = client.CreateBoardSection(body, "", nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := syncBoardItems(client, section, raw["item"].([]interface{})); err != nil {
+			return err
+		}
+		sectionOrder = append(sectionOrder, *section.Id)
+	}
+
+	for i := len(sections); i < len(existingSections); i++ {
+		log.Printf("[DEBUG] Delete board section %s", *existingSections[i].Id)
+		if _, err := client.DeleteBoardSection(*existingSections[i].Id, nil); err != nil {
+			return err
+		}
+	}
+
+	_, err := client.UpdateBoard(*board.Id, apiclient.WriteBoard{SectionOrder: &sectionOrder}, "", nil)
+	return err
+}
+
+// syncBoardItems makes the items of a board section match the configured
+// ones. An existing item is updated in place unless it references different
+// content, in which case it's replaced, since the API can't unset a reference.
+func syncBoardItems(client *apiclient.LookerSDK, section apiclient.BoardSection, items []interface{}) error {
+	existingItems := orderedBoardItems(section)
+
+	itemOrder := make([]string, 0, len(items))
+	for i, v := range items {
+		body := expandWriteBoardItem(v.(map[string]interface{}), *section.Id, int64(i))
+
+		var item apiclient.BoardItem
+		var err error
+		if i < len(existingItems) && boardItemReferencesSameContent(existingItems[i], body) {
+			log.Printf("[DEBUG] Update board item %s", *existingItems[i].Id)
+			item, err = client.UpdateBoardItem(*existingItems[i].Id, body, "", nil)
+		} else {
+			if i < len(existingItems) {
+				log.Printf("[DEBUG] Delete board item %s", *existingItems[i].Id)
+				if _, err = client.DeleteBoardItem(*existingItems[i].Id, nil); err != nil {
+					return err
+				}
+			}
+			log.Printf("[DEBUG] Create board item in board section %s", *section.Id)
+			item, err = client.CreateBoardItem(body, "", nil)
+		}
+		if err != nil {
+			return err
+		}
+		itemOrder = append(itemOrder, *item.Id)
+	}
+
+	for i := len(items); i < len(existingItems); i++ {
+		log.Printf("[DEBUG] Delete board item %s", *existingItems[i].Id)
+		if _, err := client.DeleteBoardItem(*existingItems[i].Id, nil); err != nil {
+			return err
+		}
+	}
+
+	_, err := client.UpdateBoardSection(*section.Id, apiclient.WriteBoardSection{ItemOrder: &itemOrder}, "", nil)
+	return err
+}
+
+func expandWriteBoardItem(raw map[string]interface{}, sectionID string, order int64) apiclient.WriteBoardItem {
+	body := apiclient.WriteBoardItem{
+		BoardSectionId: &sectionID,
+		Order:          &order,
+	}
+
+	if dashboardID := raw["dashboard_id"].(string); dashboardID != "" {
+		body.DashboardId = &dashboardID
+	}
+	if lookID := raw["look_id"].(string); lookID != "" {
+		body.LookId = &lookID
+	}
+	if lookmlDashboardID := raw["lookml_dashboard_id"].(string); lookmlDashboardID != "" {
+		body.LookmlDashboardId = &lookmlDashboardID
+	}
+	url := raw["url"].(string)
+	useCustomURL := url != ""
+	if useCustomURL {
+		body.CustomUrl = &url
+	}
+	body.UseCustomUrl = &useCustomURL
+
+	title := raw["title"].(string)
+	useCustomTitle := title != ""
+	body.CustomTitle = &title
+	body.UseCustomTitle = &useCustomTitle
+
+	description := raw["description"].(string)
+	useCustomDescription := description != ""
+	body.CustomDescription = &description
+	body.UseCustomDescription = &useCustomDescription
+
+	return body
+}
+
+func boardItemReferencesSameContent(item apiclient.BoardItem, body apiclient.WriteBoardItem) bool {
+	return derefString(item.DashboardId) == derefString(body.DashboardId) &&
+		derefString(item.LookId) == derefString(body.LookId) &&
+		derefString(item.LookmlDashboardId) == derefString(body.LookmlDashboardId)
+}
+
+func flattenBoardSections(board apiclient.Board) []interface{} {
+	sections := orderedBoardSections(board)
+
+	vs := make([]interface{}, 0, len(sections))
+	for _, section := range sections {
+		items := orderedBoardItems(section)
+		itemList := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			v := map[string]interface{}{
+				"id":                  derefString(item.Id),
+				"dashboard_id":        derefString(item.DashboardId),
+				"look_id":             derefString(item.LookId),
+				"lookml_dashboard_id": derefString(item.LookmlDashboardId),
+			}
+			if derefBool(item.UseCustomUrl) {
+				v["url"] = derefString(item.CustomUrl)
+			}
+			if derefBool(item.UseCustomTitle) {
+				v["title"] = derefString(item.CustomTitle)
+			}
+			if derefBool(item.UseCustomDescription) {
+				v["description"] = derefString(item.CustomDescription)
+			}
+			itemList = append(itemList, v)
+		}
+
+		vs = append(vs, map[string]interface{}{
+			"id":          derefString(section.Id),
+			"title":       derefString(section.Title),
+			"description": derefString(section.Description),
+			"item":        itemList,
+		})
+	}
+	return vs
+}
+
+// orderedBoardSections returns the sections of a board in the order they are
+// displayed in, followed by any section missing from the section order.
+func orderedBoardSections(board apiclient.Board) []apiclient.BoardSection {
+	var sections []apiclient.BoardSection
+	if board.BoardSections != nil {
+		sections = *board.BoardSections
+	}
+	var sectionOrder []string
+	if board.SectionOrder != nil {
+		sectionOrder = *board.SectionOrder
+	}
+
+	byID := make(map[string]apiclient.BoardSection, len(sections))
+	for _, section := range sections {
+		byID[*section.Id] = section
+	}
+
+	ordered := make([]apiclient.BoardSection, 0, len(sections))
+	for _, id := range sectionOrder {
+		if section, ok := byID[id]; ok {
+			ordered = append(ordered, section)
+			delete(byID, id)
+		}
+	}
+	for _, section := range sections {
+		if _, ok := byID[*section.Id]; ok {
+			ordered = append(ordered, section)
+		}
+	}
+	return ordered
+}
+
+// orderedBoardItems returns the items of a board section in the order they
+// are displayed in, followed by any item missing from the item order.
+func orderedBoardItems(section apiclient.BoardSection) []apiclient.BoardItem {
+	var items []apiclient.BoardItem
+	if section.BoardItems != nil {
+		items = *section.BoardItems
+	}
+	var itemOrder []string
+	if section.ItemOrder != nil {
+		itemOrder = *section.ItemOrder
+	}
+
+	byID := make(map[string]apiclient.BoardItem, len(items))
+	for _, item := range items {
+		byID[*item.Id] = item
+	}
+
+	ordered := make([]apiclient.BoardItem, 0, len(items))
+	for _, id := range itemOrder {
+		if item, ok := byID[id]; ok {
+			ordered = append(ordered, item)
+			delete(byID, id)
+		}
+	}
+	for _, item := range items {
+		if _, ok := byID[*item.Id]; ok {
+			ordered = append(ordered, item)
+		}
+	}
+	return ordered
+}
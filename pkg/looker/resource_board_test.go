@@ -0,0 +1,158 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Board(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: boardConfig(name, "Reports"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_board.test", "title", name),
+					resource.TestCheckResourceAttr("looker_board.test", "section.#", "1"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.title", "Reports"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.item.#", "2"),
+					resource.TestCheckResourceAttrPair("looker_board.test", "section.0.item.0.look_id", "looker_look.board_test", "id"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.item.1.url", "https://example.com/runbook"),
+				),
+			},
+			{
+				Config: boardConfig(name, "Weekly reports"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.title", "Weekly reports"),
+					resource.TestCheckResourceAttr("looker_board.test", "section.0.item.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "looker_board.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckBoardDestroy,
+	})
+}
+
+func TestBoardCustomizeDiff(t *testing.T) {
+	tests := map[string]struct {
+		item    map[string]interface{}
+		wantErr string
+	}{
+		"look": {
+			item: map[string]interface{}{"look_id": "1"},
+		},
+		"url": {
+			item: map[string]interface{}{"url": "https://example.com", "title": "Example"},
+		},
+		"unknown reference": {
+			item: map[string]interface{}{"dashboard_id": unknownValue, "look_id": "1"},
+		},
+		"no reference": {
+			item:    map[string]interface{}{"title": "Example"},
+			wantErr: "section.0.item.1 must set exactly one of dashboard_id, look_id, lookml_dashboard_id, url",
+		},
+		"several references": {
+			item:    map[string]interface{}{"dashboard_id": "1", "look_id": "1"},
+			wantErr: "section.0.item.1 must set exactly one of dashboard_id, look_id, lookml_dashboard_id, url",
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			config := map[string]interface{}{
+				"title": "Reports",
+				"section": []interface{}{
+					map[string]interface{}{
+						"item": []interface{}{
+							map[string]interface{}{"look_id": "2"},
+							tt.item,
+						},
+					},
+				},
+			}
+			_, err := resourceBoard().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderedBoardSections(t *testing.T) {
+	ids := []string{"1", "2", "3"}
+	sections := []apiclient.BoardSection{{Id: &ids[0]}, {Id: &ids[1]}, {Id: &ids[2]}}
+	sectionOrder := []string{"3", "1"}
+	board := apiclient.Board{BoardSections: &sections, SectionOrder: &sectionOrder}
+
+	var actual []string
+	for _, section := range orderedBoardSections(board) {
+		actual = append(actual, *section.Id)
+	}
+	assert.Equal(t, []string{"3", "1", "2"}, actual)
+}
+
+func testAccCheckBoardDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_board" {
+			continue
+		}
+
+		_, err := client.Board(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("board still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func boardConfig(name, sectionTitle string) string {
+	return fmt.Sprintf(`
+	resource "looker_look" "board_test" {
+		title     = "%s"
+		folder_id = "1"
+		query {
+			model  = "system__activity"
+			view   = "user"
+			fields = ["user.count"]
+		}
+	}
+	resource "looker_board" "test" {
+		title = "%s"
+		section {
+			title = "%s"
+			item {
+				look_id = looker_look.board_test.id
+			}
+			item {
+				url   = "https://example.com/runbook"
+				title = "Runbook"
+			}
+		}
+	}
+	`, name, name, sectionTitle)
+}
@@ -2,7 +2,12 @@ package looker
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"log"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,6 +16,71 @@ import (
 	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
+// connectionTests are the names of the tests Looker can run on a connection.
+// Which of them apply depends on the dialect.
+var connectionTests = []string{
+	"connect",
+	"kill",
+	"query",
+	"database_timezone",
+	"database_version",
+	"tmp_table",
+	"tmp_db",
+	"tmp_db_views",
+	"mysql_tmp_db",
+	"cdt",
+	"pdt",
+	"query_history",
+	"bq_storage_api",
+}
+
+// connectionDialectBlocks are the typed blocks of dialects with their own
+// settings, with the prefix of the dialects they can be used with, and the flat
+// attributes they set instead.
+var connectionDialectBlocks = []struct {
+	key           string
+	dialectPrefix string
+	covers        []string
+}{
+	{"bigquery", "bigquery", []string{"host", "database", "username", "max_billing_gigabytes"}},
+	{"snowflake", "snowflake", nil},
+	{"postgres", "postgres", []string{"host", "port", "database", "schema", "ssl", "verify_ssl"}},
+}
+
+// snowflakeParams are the settings of the snowflake block that are passed to
+// Looker as JDBC parameters.
+var snowflakeParams = []string{"account", "warehouse", "role"}
+
+// dialectOptions are the connection attributes that only apply to dialects
+// supporting the given option, in the order they're reported.
+var dialectOptions = []struct {
+	key       string
+	supported func(apiclient.DialectInfoOptions) *bool
+}{
+	{"password", func(o apiclient.DialectInfoOptions) *bool { return o.Auth }},
+	{"schema", func(o apiclient.DialectInfoOptions) *bool { return o.Schema }},
+	{"ssl", func(o apiclient.DialectInfoOptions) *bool { return o.Ssl }},
+	{"verify_ssl", func(o apiclient.DialectInfoOptions) *bool { return o.Ssl }},
+	{"db_timezone", func(o apiclient.DialectInfoOptions) *bool { return o.Timezone }},
+	{"query_timezone", func(o apiclient.DialectInfoOptions) *bool { return o.Timezone }},
+	{"tmp_db_name", func(o apiclient.DialectInfoOptions) *bool { return o.TmpTable }},
+	{"jdbc_additional_params", func(o apiclient.DialectInfoOptions) *bool { return o.AdditionalParams }},
+	{"certificate", func(o apiclient.DialectInfoOptions) *bool { return o.OauthCredentials }},
+	{"certificate_file", func(o apiclient.DialectInfoOptions) *bool { return o.OauthCredentials }},
+	{"file_type", func(o apiclient.DialectInfoOptions) *bool { return o.OauthCredentials }},
+}
+
+// dialectFeatures are the connection attributes that only apply to dialects
+// with the given feature. Looker only reports the features of the dialect of
+// existing connections, so they can't be checked before creating one.
+var dialectFeatures = []struct {
+	key       string
+	supported func(apiclient.Dialect) *bool
+}{
+	{"max_billing_gigabytes", func(d apiclient.Dialect) *bool { return d.SupportsCostEstimate }},
+	{"pdt_concurrency", func(d apiclient.Dialect) *bool { return d.SupportsPersistentDerivedTables }},
+}
+
 func resourceConnection() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceConnectionCreate,
@@ -20,6 +90,7 @@ func resourceConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceConnectionImport,
 		},
+		CustomizeDiff: resourceConnectionCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -31,44 +102,72 @@ func resourceConnection() *schema.Resource {
 				ValidateFunc: validation.StringDoesNotContainAny(" "),
 			},
 			"host": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Description: "Required unless it's set by a `bigquery`, `snowflake` or `postgres` block.",
+				Optional:    true,
 			},
 			"port": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"username": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Description: "Required unless it's set by a `bigquery` block.",
+				Optional:    true,
 			},
 			"password": {
-				Type:      schema.TypeString,
+				Type: schema.TypeString,
+				Description: "Password for server authentication. Changes are only sent to Looker when " +
+					"`password_version` changes, since the Looker API doesn't return the password.",
 				Optional:  true,
-				ForceNew:  true,
 				Sensitive: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return d.Id() != ""
 				},
 			},
+			"password_version": {
+				Type: schema.TypeString,
+				Description: "Arbitrary value that sends `password` to Looker again when changed, e.g. to " +
+					"rotate it. The connection is updated in place, then tested with the new password.",
+				Optional: true,
+			},
 			"certificate": {
-				Type:        schema.TypeString,
+				Type: schema.TypeString,
 				Description: "Base64 encoded certificate body for server authentication (when " +
-										 "appropriate for the dialect). Due to limitations in the Looker " +
-										 "API, changes made outside of Terraform cannot be detected.",
-				Optional:    true,
-				Sensitive:   true,
-				StateFunc:   hash,
+					"appropriate for the dialect). Due to limitations in the Looker " +
+					"API, changes made outside of Terraform cannot be detected.",
+				Optional:      true,
+				Sensitive:     true,
+				StateFunc:     hash,
+				ConflictsWith: []string{"certificate_file"},
+			},
+			"certificate_file": {
+				Type: schema.TypeString,
+				Description: "Path of the certificate key file for server authentication, instead of " +
+					"`certificate`. The SHA-256 of its content is stored, so replacing the file updates " +
+					"the connection.",
+				Optional:      true,
+				StateFunc:     hashFile,
+				ValidateFunc:  validateFileExists,
+				ConflictsWith: []string{"certificate"},
+			},
+			"certificate_version": {
+				Type: schema.TypeString,
+				Description: "Arbitrary value that sends `certificate` to Looker again when changed, e.g. to " +
+					"rotate a key that is read from outside the configuration. The connection is updated " +
+					"in place, then tested with the new certificate.",
+				Optional: true,
 			},
 			"file_type": {
 				Type:         schema.TypeString,
-				Description:  "Certificate key file type (.json or .p12).",
+				Description:  "Certificate key file type (.json or .p12). Detected from `certificate_file` if not set.",
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{".json", ".p12"}, false),
 			},
 			"database": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Description: "Required unless it's set by a `bigquery` or `postgres` block.",
+				Optional:    true,
 			},
 			"db_timezone": {
 				Type:     schema.TypeString,
@@ -115,9 +214,106 @@ func resourceConnection() *schema.Resource {
 				Optional: true,
 			},
 			"dialect_name": {
-				Type:     schema.TypeString,
+				Type: schema.TypeString,
+				Description: "Name of the dialect of the database. Must be one of the dialects of the " +
+					"`looker_dialects` data source, and the options set must be supported by it.",
 				Required: true,
 			},
+			"bigquery": {
+				Type:        schema.TypeList,
+				Description: "BigQuery settings, instead of `host`, `database`, `username`, `certificate` and `file_type`.",
+				Optional:    true,
+				MaxItems:    1,
+				ConflictsWith: []string{"snowflake", "postgres", "host", "database", "username", "certificate",
+					"certificate_file", "file_type", "max_billing_gigabytes"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project": {
+							Type:        schema.TypeString,
+							Description: "ID of the Google Cloud project that is billed for queries.",
+							Required:    true,
+						},
+						"dataset": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_account_json": {
+							Type: schema.TypeString,
+							Description: "JSON key of the service account Looker authenticates as. Due to limitations " +
+								"in the Looker API, changes made outside of Terraform cannot be detected.",
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+						"max_billing_gigabytes": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"snowflake": {
+				Type: schema.TypeList,
+				Description: "Snowflake settings, which are added to `jdbc_additional_params`. `host` defaults to " +
+					"the host of the account.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"bigquery", "postgres"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account": {
+							Type:        schema.TypeString,
+							Description: "Account identifier, e.g. `xy12345.us-east-1`.",
+							Required:    true,
+						},
+						"warehouse": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"postgres": {
+				Type:          schema.TypeList,
+				Description:   "PostgreSQL settings, instead of `host`, `port`, `database`, `schema`, `ssl` and `verify_ssl`.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"bigquery", "snowflake", "host", "port", "database", "schema", "ssl", "verify_ssl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "5432",
+						},
+						"database": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"schema": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "public",
+						},
+						"ssl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"verify_ssl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"user_db_credentials": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -150,14 +346,16 @@ func resourceConnection() *schema.Resource {
 				Optional: true,
 			},
 			"pdt_context_override": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:        schema.TypeList,
+				Description: "Connection settings used when building persistent derived tables.",
+				Optional:    true,
+				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"context": {
 							Type:         schema.TypeString,
 							Optional:     true,
+							Default:      "pdt",
 							ValidateFunc: validation.StringInSlice([]string{"pdt"}, false),
 						},
 						"host": {
@@ -170,25 +368,28 @@ func resourceConnection() *schema.Resource {
 						},
 						"username": {
 							Type:     schema.TypeString,
-							ForceNew: true,
 							Optional: true,
 						},
-						"passowrd": {
-							Type:      schema.TypeString,
-							ForceNew:  true,
+						"password": {
+							Type: schema.TypeString,
+							Description: "Password for server authentication. Due to limitations in the Looker API, " +
+								"changes made outside of Terraform cannot be detected.",
 							Optional:  true,
 							Sensitive: true,
 						},
 						"certificate": {
-							Type:      schema.TypeString,
-							ForceNew:  true,
+							Type: schema.TypeString,
+							Description: "Base64 encoded certificate body for server authentication (when " +
+								"appropriate for the dialect). Due to limitations in the Looker API, changes " +
+								"made outside of Terraform cannot be detected.",
 							Optional:  true,
 							Sensitive: true,
 						},
 						"file_type": {
-							Type:     schema.TypeString,
-							ForceNew: true,
-							Optional: true,
+							Type:         schema.TypeString,
+							Description:  "Certificate key file type (.json or .p12).",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{".json", ".p12"}, false),
 						},
 						"database": {
 							Type:     schema.TypeString,
@@ -210,8 +411,9 @@ func resourceConnection() *schema.Resource {
 				},
 			},
 			"tunnel_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:        schema.TypeString,
+				Description: "ID of the `looker_ssh_tunnel` to connect to the database through.",
+				Optional:    true,
 			},
 			"pdt_concurrency": {
 				Type:     schema.TypeInt,
@@ -228,8 +430,31 @@ func resourceConnection() *schema.Resource {
 				},
 			},
 			"oauth_application_id": {
-				Type:     schema.TypeString,
+				Type:        schema.TypeString,
+				Description: "ID of the `looker_external_oauth_application` users authenticate to the database with.",
+				Optional:    true,
+			},
+			"test_on_apply": {
+				Type: schema.TypeList,
+				Description: "Tests the connection after every create and update, failing the apply when a test " +
+					"returns an error. The tests run against the saved connection, so the changes are already " +
+					"in Looker when they fail: a new connection is replaced on the next apply, and an updated " +
+					"one keeps the new settings until they are fixed.",
 				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tests": {
+							Type:        schema.TypeList,
+							Description: "Tests to run. Defaults to every test supported by the dialect.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(connectionTests, false),
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -250,6 +475,10 @@ func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	d.SetId(*result.Name)
 
+	if diags := testConnectionOnApply(client, d); diags.HasError() {
+		return diags
+	}
+
 	return resourceConnectionRead(ctx, d, m)
 }
 
@@ -266,7 +495,23 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	return diag.FromErr(flattenConnection(connection, d))
+	if err = flattenConnection(connection, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("certificate").(string) != "" || d.Get("certificate_file").(string) != "" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Certificate of connection %s is not refreshed", connectionName),
+				Detail: "The Looker API doesn't return certificates, so changes made outside of Terraform " +
+					"can't be detected. Replace the certificate file or change certificate_version to " +
+					"send it again.",
+			},
+		}
+	}
+
+	return nil
 }
 
 func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -283,6 +528,30 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	// leaving the override out of the update keeps it, so it's deleted instead
+	if o, n := d.GetChange("pdt_context_override"); len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+		overrideContext := o.([]interface{})[0].(map[string]interface{})["context"].(string)
+		log.Printf("[DEBUG] Delete %s context override of connection %s", overrideContext, name)
+		if _, err = client.DeleteConnectionOverride(name, overrideContext, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if diags := testConnectionOnApply(client, d); diags.HasError() {
+		return diags
+	}
+
+	// make sure rotated secrets work, even if test_on_apply isn't configured
+	if d.HasChanges("password_version", "certificate_version") && len(d.Get("test_on_apply").([]interface{})) == 0 {
+		results, err := testConnection(client, name, []string{"connect"})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if diags := connectionTestDiagnostics(name, results); diags.HasError() {
+			return diags
+		}
+	}
+
 	return resourceConnectionRead(ctx, d, m)
 }
 
@@ -306,37 +575,264 @@ func resourceConnectionImport(ctx context.Context, d *schema.ResourceData, m int
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceConnectionCustomizeDiff checks the dialect against the dialects
+// Looker knows about, so that unknown dialects and options the dialect doesn't
+// support fail at plan time rather than on apply.
+func resourceConnectionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("dialect_name") {
+		return nil
+	}
+
+	if err := validateConnectionDialectBlocks(d, d.Get("dialect_name").(string)); err != nil {
+		return err
+	}
+
+	keys := []string{"dialect_name"}
+	for _, option := range dialectOptions {
+		keys = append(keys, option.key)
+	}
+	for _, feature := range dialectFeatures {
+		keys = append(keys, feature.key)
+	}
+	if d.Id() != "" && !d.HasChanges(keys...) {
+		return nil
+	}
+
+	client := m.(*apiclient.LookerSDK)
+
+	dialects, err := client.AllDialectInfos("name,supported_options", nil)
+	if err != nil {
+		return err
+	}
+
+	if err = validateConnectionDialect(d, d.Get("dialect_name").(string), dialects); err != nil {
+		return err
+	}
+
+	if d.Id() == "" || d.HasChange("dialect_name") {
+		return nil
+	}
+
+	connection, err := client.Connection(d.Id(), "dialect", nil)
+	if err != nil {
+		return err
+	}
+	if connection.Dialect == nil {
+		return nil
+	}
+
+	return validateConnectionDialectFeatures(d, d.Get("dialect_name").(string), *connection.Dialect)
+}
+
+// validateConnectionDialect returns an error if the dialect isn't one of the
+// given dialects, or if options it doesn't support are set.
+func validateConnectionDialect(d interface {
+	GetOk(string) (interface{}, bool)
+}, dialectName string, dialects []apiclient.DialectInfo) error {
+	var dialect *apiclient.DialectInfo
+	for i := range dialects {
+		if derefString(dialects[i].Name) == dialectName {
+			dialect = &dialects[i]
+			break
+		}
+	}
+	if dialect == nil {
+		return fmt.Errorf("unknown dialect %q, see the looker_dialects data source for the available dialects", dialectName)
+	}
+	if dialect.SupportedOptions == nil {
+		return nil
+	}
+
+	var unsupported []string
+	for _, option := range dialectOptions {
+		supported := option.supported(*dialect.SupportedOptions)
+		if supported == nil || *supported {
+			continue
+		}
+		if _, ok := d.GetOk(option.key); ok {
+			unsupported = append(unsupported, option.key)
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("dialect %s doesn't support %s", dialectName, strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+// validateConnectionDialectFeatures returns an error if attributes that need
+// a feature the dialect doesn't have are set.
+func validateConnectionDialectFeatures(d interface {
+	GetOk(string) (interface{}, bool)
+}, dialectName string, dialect apiclient.Dialect) error {
+	var unsupported []string
+	for _, feature := range dialectFeatures {
+		supported := feature.supported(dialect)
+		if supported == nil || *supported {
+			continue
+		}
+		if _, ok := d.GetOk(feature.key); ok {
+			unsupported = append(unsupported, feature.key)
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("dialect %s doesn't support %s", dialectName, strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+// validateConnectionDialectBlocks returns an error if a dialect block doesn't
+// match the dialect, or if a required attribute is neither set directly nor by
+// a dialect block. Attributes and blocks only known after apply are assumed to
+// be set.
+func validateConnectionDialectBlocks(d interface {
+	GetOk(string) (interface{}, bool)
+	NewValueKnown(string) bool
+}, dialectName string) error {
+	covered := map[string]bool{}
+	for _, block := range connectionDialectBlocks {
+		if d.NewValueKnown(block.key) {
+			if _, ok := d.GetOk(block.key); !ok {
+				continue
+			}
+			if !strings.HasPrefix(dialectName, block.dialectPrefix) {
+				return fmt.Errorf("%s block can't be used with dialect %s", block.key, dialectName)
+			}
+		}
+		for _, key := range block.covers {
+			covered[key] = true
+		}
+		if block.key == "snowflake" {
+			covered["host"] = true
+		}
+	}
+
+	for _, key := range []string{"host", "database", "username"} {
+		if !d.NewValueKnown(key) || covered[key] {
+			continue
+		}
+		if _, ok := d.GetOk(key); !ok {
+			return fmt.Errorf("%q is required", key)
+		}
+	}
+
+	return nil
+}
+
+// testConnectionOnApply runs the tests configured in test_on_apply, if any,
+// against the saved connection. The Looker API can only test unsaved settings
+// when they include every secret, which updates don't resend, so failures are
+// only reported once the connection is saved.
+func testConnectionOnApply(client *apiclient.LookerSDK, d *schema.ResourceData) diag.Diagnostics {
+	testOnApply := d.Get("test_on_apply").([]interface{})
+	if len(testOnApply) == 0 {
+		return nil
+	}
+
+	var tests []string
+	if testOnApply[0] != nil {
+		tests = expandStringList(testOnApply[0].(map[string]interface{})["tests"])
+	}
+
+	results, err := testConnection(client, d.Id(), tests)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return connectionTestDiagnostics(d.Id(), results)
+}
+
+// testConnection runs the given tests on a saved connection, or every test
+// supported by its dialect when none are given.
+func testConnection(client *apiclient.LookerSDK, connectionName string, tests []string) ([]apiclient.DBConnectionTestResult, error) {
+	if len(tests) == 0 {
+		connection, err := client.Connection(connectionName, "dialect", nil)
+		if err != nil {
+			return nil, err
+		}
+		if connection.Dialect != nil && connection.Dialect.ConnectionTests != nil {
+			tests = *connection.Dialect.ConnectionTests
+		}
+	}
+
+	log.Printf("[DEBUG] Test connection %s with %v", connectionName, tests)
+
+	return client.TestConnection(connectionName, tests, nil)
+}
+
+// connectionTestDiagnostics returns an error for every failed connection test.
+func connectionTestDiagnostics(connectionName string, results []apiclient.DBConnectionTestResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, result := range results {
+		if derefString(result.Status) != "error" {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Connection %s failed the %s test", connectionName, derefString(result.Name)),
+			Detail:   derefString(result.Message),
+		})
+	}
+	return diags
+}
+
 func expandWriteDBConnection(d *schema.ResourceData) (*apiclient.WriteDBConnection, error) {
 	// required values
 	name := d.Get("name").(string)
-	host := d.Get("host").(string)
-	username := d.Get("username").(string)
-	database := d.Get("database").(string)
 	dialectName := d.Get("dialect_name").(string)
 	writeDBConnection := &apiclient.WriteDBConnection{
 		Name:        &name,
-		Host:        &host,
-		Username:    &username,
-		Database:    &database,
 		DialectName: &dialectName,
 	}
 
+	// required unless set by a dialect block
+	if v, ok := d.GetOk("host"); ok {
+		host := v.(string)
+		writeDBConnection.Host = &host
+	}
+	if v, ok := d.GetOk("username"); ok {
+		username := v.(string)
+		writeDBConnection.Username = &username
+	}
+	if v, ok := d.GetOk("database"); ok {
+		database := v.(string)
+		writeDBConnection.Database = &database
+	}
+
 	// optional values
 	if v, ok := d.GetOk("port"); ok {
 		port := v.(string) // for api breaking change
 		writeDBConnection.Port = &port
 	}
-	if v, ok := d.GetOk("password"); ok {
-		password := v.(string)
-		writeDBConnection.Password = &password
-	}
-	if v, ok := d.GetOk("certificate"); ok {
-		certificate := v.(string)
-		writeDBConnection.Certificate = &certificate
+	// secrets are write-only and hidden from the diff (password) or stored
+	// hashed (certificate), so read them from the configuration and only send
+	// them when they're new or rotated
+	if d.IsNewResource() || d.HasChange("password_version") {
+		if password, ok := configString(d, "password"); ok {
+			writeDBConnection.Password = &password
+		}
 	}
-	if v, ok := d.GetOk("file_type"); ok {
-		fileType := v.(string)
-		writeDBConnection.FileType = &fileType
+	if d.IsNewResource() || d.HasChanges("certificate", "certificate_file", "certificate_version", "file_type") {
+		if certificate, ok := configString(d, "certificate"); ok {
+			writeDBConnection.Certificate = &certificate
+		}
+		if v, ok := d.GetOk("file_type"); ok {
+			fileType := v.(string)
+			writeDBConnection.FileType = &fileType
+		}
+		if path, ok := configString(d, "certificate_file"); ok {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read certificate_file: %w", err)
+			}
+			certificate := base64.StdEncoding.EncodeToString(content)
+			writeDBConnection.Certificate = &certificate
+			if writeDBConnection.FileType == nil {
+				fileType := detectCertificateFileType(path, content)
+				writeDBConnection.FileType = &fileType
+			}
+		}
 	}
 	if v, ok := d.GetOk("db_timezone"); ok {
 		dbTimezone := v.(string)
@@ -370,7 +866,7 @@ func expandWriteDBConnection(d *schema.ResourceData) (*apiclient.WriteDBConnecti
 		tmpDbName := v.(string)
 		writeDBConnection.TmpDbName = &tmpDbName
 	}
-	if v, ok := d.GetOk("jdbc_addtional_params"); ok {
+	if v, ok := d.GetOk("jdbc_additional_params"); ok {
 		jdbcAdditionalParams := v.(string)
 		writeDBConnection.JdbcAdditionalParams = &jdbcAdditionalParams
 	}
@@ -420,65 +916,171 @@ func expandWriteDBConnection(d *schema.ResourceData) (*apiclient.WriteDBConnecti
 	writeDBConnection.UserAttributeFields = &userAttributeFields
 
 	if _, ok := d.GetOk("pdt_context_override"); ok {
-		var pdtContextOverride apiclient.WriteDBConnectionOverride
-		if v, ok := d.GetOk("pdt_context_override.0.context"); ok {
-			pdtContextOverride.Context = v.(*string)
-		}
-		if v, ok := d.GetOk("pdt_context_override.0.host"); ok {
-			pdtContextOverride.Host = v.(*string)
-		}
-		if v, ok := d.GetOk("pdt_context_override.0.port"); ok {
-			pdtContextOverride.Port = v.(*string)
-		}
-		if v, ok := d.GetOk("pdt_context_override.0.username"); ok {
-			pdtContextOverride.Username = v.(*string)
-		}
-		if v, ok := d.GetOk("pdt_context_override.0.password"); ok {
-			pdtContextOverride.Password = v.(*string)
+		writeDBConnection.PdtContextOverride = expandWriteDBConnectionOverride(d)
+	}
+
+	// dialect blocks take precedence over the flat attributes
+	if v, ok := d.GetOk("bigquery"); ok {
+		if err := expandBigQueryConnection(d, v.([]interface{})[0].(map[string]interface{}), writeDBConnection); err != nil {
+			return nil, err
 		}
-		if v, ok := d.GetOk("pdt_context_override.0.certificate"); ok {
-			pdtContextOverride.Certificate = v.(*string)
+	}
+	if v, ok := d.GetOk("snowflake"); ok {
+		expandSnowflakeConnection(v.([]interface{})[0].(map[string]interface{}), writeDBConnection)
+	}
+	if v, ok := d.GetOk("postgres"); ok {
+		expandPostgresConnection(v.([]interface{})[0].(map[string]interface{}), writeDBConnection)
+	}
+
+	return writeDBConnection, nil
+}
+
+// expandBigQueryConnection sets the connection settings of the bigquery block.
+// The service account key is write-only, so it's only sent when it changes, but
+// its email address is always used as the username.
+func expandBigQueryConnection(d *schema.ResourceData, raw map[string]interface{}, writeDBConnection *apiclient.WriteDBConnection) error {
+	project := raw["project"].(string)
+	dataset := raw["dataset"].(string)
+	serviceAccountJSON := raw["service_account_json"].(string)
+
+	var serviceAccount struct {
+		ClientEmail string `json:"client_email"`
+	}
+	if err := json.Unmarshal([]byte(serviceAccountJSON), &serviceAccount); err != nil {
+		return fmt.Errorf("failed to parse service_account_json: %w", err)
+	}
+	if serviceAccount.ClientEmail == "" {
+		return fmt.Errorf("service_account_json has no client_email")
+	}
+
+	writeDBConnection.Host = &project
+	writeDBConnection.Database = &dataset
+	writeDBConnection.Username = &serviceAccount.ClientEmail
+
+	if d.IsNewResource() || d.HasChanges("bigquery.0.service_account_json", "certificate_version") {
+		certificate := base64.StdEncoding.EncodeToString([]byte(serviceAccountJSON))
+		fileType := ".json"
+		writeDBConnection.Certificate = &certificate
+		writeDBConnection.FileType = &fileType
+	}
+
+	if maxBillingGigabytes := raw["max_billing_gigabytes"].(string); maxBillingGigabytes != "" {
+		writeDBConnection.MaxBillingGigabytes = &maxBillingGigabytes
+	}
+
+	return nil
+}
+
+// expandSnowflakeConnection adds the settings of the snowflake block to the
+// JDBC parameters, and defaults the host to the one of the account.
+func expandSnowflakeConnection(raw map[string]interface{}, writeDBConnection *apiclient.WriteDBConnection) {
+	var params []string
+	for _, key := range snowflakeParams {
+		if v := raw[key].(string); v != "" {
+			params = append(params, key+"="+v)
 		}
+	}
+	if writeDBConnection.JdbcAdditionalParams != nil && *writeDBConnection.JdbcAdditionalParams != "" {
+		params = append(params, *writeDBConnection.JdbcAdditionalParams)
+	}
+	jdbcAdditionalParams := strings.Join(params, "&")
+	writeDBConnection.JdbcAdditionalParams = &jdbcAdditionalParams
+
+	if writeDBConnection.Host == nil {
+		host := raw["account"].(string) + ".snowflakecomputing.com"
+		writeDBConnection.Host = &host
+	}
+}
+
+func expandPostgresConnection(raw map[string]interface{}, writeDBConnection *apiclient.WriteDBConnection) {
+	host := raw["host"].(string)
+	port := raw["port"].(string)
+	database := raw["database"].(string)
+	schema := raw["schema"].(string)
+	ssl := raw["ssl"].(bool)
+	verifySsl := raw["verify_ssl"].(bool)
+
+	writeDBConnection.Host = &host
+	writeDBConnection.Port = &port
+	writeDBConnection.Database = &database
+	writeDBConnection.Schema = &schema
+	writeDBConnection.Ssl = &ssl
+	writeDBConnection.VerifySsl = &verifySsl
+}
+
+// expandWriteDBConnectionOverride builds the PDT context override. Every
+// setting is sent, so that cleared settings are cleared in Looker too, but the
+// password and certificate are write-only, so they are only sent when they
+// change.
+func expandWriteDBConnectionOverride(d *schema.ResourceData) *apiclient.WriteDBConnectionOverride {
+	var pdtContextOverride apiclient.WriteDBConnectionOverride
+
+	getString := func(key string) *string {
+		value := d.Get("pdt_context_override.0." + key).(string)
+		return &value
+	}
+
+	pdtContextOverride.Context = getString("context")
+	pdtContextOverride.Host = getString("host")
+	pdtContextOverride.Port = getString("port")
+	pdtContextOverride.Username = getString("username")
+	pdtContextOverride.Database = getString("database")
+	pdtContextOverride.Schema = getString("schema")
+	pdtContextOverride.JdbcAdditionalParams = getString("jdbc_additional_params")
+	pdtContextOverride.AfterConnectStatements = getString("after_connect_statements")
+
+	if d.IsNewResource() || d.HasChange("pdt_context_override.0.password") {
+		pdtContextOverride.Password = getString("password")
+	}
+	if d.IsNewResource() || d.HasChanges("pdt_context_override.0.certificate", "pdt_context_override.0.file_type") {
+		pdtContextOverride.Certificate = getString("certificate")
+		// the file type can't be cleared, only set to one of the valid types
 		if v, ok := d.GetOk("pdt_context_override.0.file_type"); ok {
-			pdtContextOverride.FileType = v.(*string)
-		}
-		if v, ok := d.GetOk("pdt_context_override.0.database"); ok {
-			pdtContextOverride.Database = v.(*string)
-		}
-		if v, ok := d.GetOk("pdt_context_override.0.schema"); ok {
-			pdtContextOverride.Schema = v.(*string)
-		}
-		if v, ok := d.GetOk("pdt_context_override.0.jdbc_additional_params"); ok {
-			pdtContextOverride.JdbcAdditionalParams = v.(*string)
+			fileType := v.(string)
+			pdtContextOverride.FileType = &fileType
 		}
-		if v, ok := d.GetOk("pdt_context_override.0.after_connect_statements"); ok {
-			pdtContextOverride.AfterConnectStatements = v.(*string)
-		}
-
-		writeDBConnection.PdtContextOverride = &pdtContextOverride
 	}
 
-	return writeDBConnection, nil
+	return &pdtContextOverride
 }
 
 func flattenConnection(connection apiclient.DBConnection, d *schema.ResourceData) error {
-	if err := d.Set("name", connection.Name); err != nil {
-		return err
+	// attributes set by a dialect block are read into the block instead
+	covered := map[string]bool{}
+	for _, block := range connectionDialectBlocks {
+		if len(d.Get(block.key).([]interface{})) == 0 {
+			continue
+		}
+		for _, key := range block.covers {
+			covered[key] = true
+		}
+		if block.key == "snowflake" && d.Get("host").(string) == "" {
+			covered["host"] = true
+		}
 	}
-	if err := d.Set("host", connection.Host); err != nil {
+
+	if err := d.Set("name", connection.Name); err != nil {
 		return err
 	}
-	if err := d.Set("port", connection.Port); err != nil {
-		return err
+	if !covered["host"] {
+		if err := d.Set("host", connection.Host); err != nil {
+			return err
+		}
 	}
-	if err := d.Set("username", connection.Username); err != nil {
-		return err
+	if !covered["port"] {
+		if err := d.Set("port", connection.Port); err != nil {
+			return err
+		}
 	}
-	if err := d.Set("password", connection.Password); err != nil {
-		return err
+	if !covered["username"] {
+		if err := d.Set("username", connection.Username); err != nil {
+			return err
+		}
 	}
-	if err := d.Set("database", connection.Database); err != nil {
-		return err
+	if !covered["database"] {
+		if err := d.Set("database", connection.Database); err != nil {
+			return err
+		}
 	}
 	if err := d.Set("db_timezone", connection.DbTimezone); err != nil {
 		return err
@@ -486,27 +1088,67 @@ func flattenConnection(connection apiclient.DBConnection, d *schema.ResourceData
 	if err := d.Set("query_timezone", connection.QueryTimezone); err != nil {
 		return err
 	}
-	if err := d.Set("schema", connection.Schema); err != nil {
-		return err
+	if !covered["schema"] {
+		if err := d.Set("schema", connection.Schema); err != nil {
+			return err
+		}
 	}
 	if err := d.Set("max_connections", connection.MaxConnections); err != nil {
 		return err
 	}
-	if err := d.Set("max_billing_gigabytes", connection.MaxBillingGigabytes); err != nil {
-		return err
+	if !covered["max_billing_gigabytes"] {
+		if err := d.Set("max_billing_gigabytes", connection.MaxBillingGigabytes); err != nil {
+			return err
+		}
 	}
-	if err := d.Set("ssl", connection.Ssl); err != nil {
-		return err
+	if !covered["ssl"] {
+		if err := d.Set("ssl", connection.Ssl); err != nil {
+			return err
+		}
 	}
-	if err := d.Set("verify_ssl", connection.VerifySsl); err != nil {
-		return err
+	if !covered["verify_ssl"] {
+		if err := d.Set("verify_ssl", connection.VerifySsl); err != nil {
+			return err
+		}
 	}
 	if err := d.Set("tmp_db_name", connection.TmpDbName); err != nil {
 		return err
 	}
-	if err := d.Set("jdbc_additional_params", connection.JdbcAdditionalParams); err != nil {
+	jdbcAdditionalParams := derefString(connection.JdbcAdditionalParams)
+	if len(d.Get("snowflake").([]interface{})) > 0 {
+		var snowflake map[string]interface{}
+		snowflake, jdbcAdditionalParams = flattenSnowflakeConnection(jdbcAdditionalParams)
+		if err := d.Set("snowflake", []interface{}{snowflake}); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("jdbc_additional_params", jdbcAdditionalParams); err != nil {
 		return err
 	}
+	if len(d.Get("bigquery").([]interface{})) > 0 {
+		bigquery := map[string]interface{}{
+			"project":               derefString(connection.Host),
+			"dataset":               derefString(connection.Database),
+			"service_account_json":  d.Get("bigquery.0.service_account_json"),
+			"max_billing_gigabytes": derefString(connection.MaxBillingGigabytes),
+		}
+		if err := d.Set("bigquery", []interface{}{bigquery}); err != nil {
+			return err
+		}
+	}
+	if len(d.Get("postgres").([]interface{})) > 0 {
+		postgres := map[string]interface{}{
+			"host":       derefString(connection.Host),
+			"port":       derefString(connection.Port),
+			"database":   derefString(connection.Database),
+			"schema":     derefString(connection.Schema),
+			"ssl":        derefBool(connection.Ssl),
+			"verify_ssl": derefBool(connection.VerifySsl),
+		}
+		if err := d.Set("postgres", []interface{}{postgres}); err != nil {
+			return err
+		}
+	}
 	if err := d.Set("pool_timeout", connection.PoolTimeout); err != nil {
 		return err
 	}
@@ -531,40 +1173,8 @@ func flattenConnection(connection apiclient.DBConnection, d *schema.ResourceData
 		return err
 	}
 
-	if connection.PdtContextOverride != nil {
-		pdtContextOverride := make(map[string]interface{})
-
-		if connection.PdtContextOverride.Context != nil {
-			pdtContextOverride["context"] = *connection.PdtContextOverride.Context
-		}
-		if connection.PdtContextOverride.Host != nil {
-			pdtContextOverride["host"] = *connection.PdtContextOverride.Host
-		}
-		if connection.PdtContextOverride.Port != nil {
-			pdtContextOverride["port"] = *connection.PdtContextOverride.Port
-		}
-		if connection.PdtContextOverride.Username != nil {
-			pdtContextOverride["username"] = *connection.PdtContextOverride.Username
-		}
-		if connection.PdtContextOverride.Password != nil {
-			pdtContextOverride["password"] = *connection.PdtContextOverride.Password
-		}
-		if connection.PdtContextOverride.Certificate != nil {
-			pdtContextOverride["certificate"] = *connection.PdtContextOverride.Certificate
-		}
-		if connection.PdtContextOverride.FileType != nil {
-			pdtContextOverride["file_type"] = *connection.PdtContextOverride.FileType
-		}
-		if connection.PdtContextOverride.Database != nil {
-			pdtContextOverride["database"] = *connection.PdtContextOverride.Database
-		}
-		if connection.PdtContextOverride.Schema != nil {
-			pdtContextOverride["schema"] = *connection.PdtContextOverride.Schema
-		}
-
-		if err := d.Set("pdt_context_override", []map[string]interface{}{pdtContextOverride}); err != nil {
-			return err
-		}
+	if err := d.Set("pdt_context_override", flattenDBConnectionOverride(connection.PdtContextOverride, d)); err != nil {
+		return err
 	}
 	if err := d.Set("tunnel_id", connection.TunnelId); err != nil {
 		return err
@@ -580,3 +1190,67 @@ func flattenConnection(connection apiclient.DBConnection, d *schema.ResourceData
 	}
 	return nil
 }
+
+// detectCertificateFileType returns the file type of a certificate key file,
+// from its extension or else its content, since PKCS #12 files are binary.
+func detectCertificateFileType(path string, content []byte) string {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json", ".p12":
+		return ext
+	case ".pfx":
+		return ".p12"
+	}
+	if json.Valid(content) {
+		return ".json"
+	}
+	return ".p12"
+}
+
+// flattenSnowflakeConnection takes the settings of the snowflake block out of
+// the JDBC parameters, returning the block and the remaining parameters.
+func flattenSnowflakeConnection(jdbcAdditionalParams string) (map[string]interface{}, string) {
+	snowflake := map[string]interface{}{}
+	for _, key := range snowflakeParams {
+		snowflake[key] = ""
+	}
+
+	var params []string
+	for _, param := range strings.Split(jdbcAdditionalParams, "&") {
+		if param == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(param, "=")
+		if _, ok := snowflake[key]; ok {
+			snowflake[key] = value
+			continue
+		}
+		params = append(params, param)
+	}
+
+	return snowflake, strings.Join(params, "&")
+}
+
+// flattenDBConnectionOverride converts the PDT context override returned by
+// the API. Its password, certificate and file type are write-only, so they're
+// carried over from the state.
+func flattenDBConnectionOverride(override *apiclient.DBConnectionOverride, d *schema.ResourceData) []interface{} {
+	if override == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"context":                  derefString(override.Context),
+			"host":                     derefString(override.Host),
+			"port":                     derefString(override.Port),
+			"username":                 derefString(override.Username),
+			"password":                 d.Get("pdt_context_override.0.password"),
+			"certificate":              d.Get("pdt_context_override.0.certificate"),
+			"file_type":                d.Get("pdt_context_override.0.file_type"),
+			"database":                 derefString(override.Database),
+			"schema":                   derefString(override.Schema),
+			"jdbc_additional_params":   derefString(override.JdbcAdditionalParams),
+			"after_connect_statements": derefString(override.AfterConnectStatements),
+		},
+	}
+}
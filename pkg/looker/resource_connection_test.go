@@ -1,14 +1,23 @@
 package looker
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAcc_Connection(t *testing.T) {
@@ -80,6 +89,283 @@ func testAccCheckConnectionDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestConnectionPdtContextOverride(t *testing.T) {
+	api := &mockConnectionAPI{}
+	client := newTestClient(t, api.ServeHTTP)
+
+	override := map[string]interface{}{
+		"username":                 "looker_pdt",
+		"password":                 "pdt-secret",
+		"certificate":              "Y2VydGlmaWNhdGU=",
+		"file_type":                ".p12",
+		"jdbc_additional_params":   "sslmode=require",
+		"after_connect_statements": "SET search_path TO pdt",
+	}
+	config := map[string]interface{}{
+		"name":                 "test_conn",
+		"host":                 "warehouse.example.com",
+		"username":             "looker",
+		"database":             "analytics",
+		"dialect_name":         "postgres",
+		"pdt_context_override": []interface{}{override},
+	}
+
+	state, diags := applyTestResourceChange(t, resourceConnection(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	written := api.written.PdtContextOverride
+	if assert.NotNil(t, written) {
+		assert.Equal(t, "pdt", derefString(written.Context))
+		assert.Equal(t, "looker_pdt", derefString(written.Username))
+		assert.Equal(t, "pdt-secret", derefString(written.Password))
+		assert.Equal(t, "Y2VydGlmaWNhdGU=", derefString(written.Certificate))
+		assert.Equal(t, ".p12", derefString(written.FileType))
+		assert.Equal(t, "sslmode=require", derefString(written.JdbcAdditionalParams))
+		assert.Equal(t, "SET search_path TO pdt", derefString(written.AfterConnectStatements))
+	}
+
+	// the API doesn't return the secrets, so they're kept from the state
+	assert.Equal(t, "looker_pdt", state.Attributes["pdt_context_override.0.username"])
+	assert.Equal(t, "pdt-secret", state.Attributes["pdt_context_override.0.password"])
+	assert.Equal(t, "Y2VydGlmaWNhdGU=", state.Attributes["pdt_context_override.0.certificate"])
+	assert.Equal(t, ".p12", state.Attributes["pdt_context_override.0.file_type"])
+	assert.Equal(t, "sslmode=require", state.Attributes["pdt_context_override.0.jdbc_additional_params"])
+	assert.Equal(t, "SET search_path TO pdt", state.Attributes["pdt_context_override.0.after_connect_statements"])
+
+	// changing the host of the override updates it in place without resending the secrets
+	override["host"] = "pdt.example.com"
+
+	newState, diags := applyTestResourceChange(t, resourceConnection(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, state.ID, newState.ID)
+
+	written = api.written.PdtContextOverride
+	if assert.NotNil(t, written) {
+		assert.Equal(t, "pdt.example.com", derefString(written.Host))
+		assert.Nil(t, written.Password)
+		assert.Nil(t, written.Certificate)
+	}
+	assert.Equal(t, "pdt.example.com", newState.Attributes["pdt_context_override.0.host"])
+	assert.Equal(t, "pdt-secret", newState.Attributes["pdt_context_override.0.password"])
+
+	// changing the password updates it in place
+	override["password"] = "new-pdt-secret"
+
+	newState, diags = applyTestResourceChange(t, resourceConnection(), newState, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, state.ID, newState.ID)
+	assert.Equal(t, "new-pdt-secret", derefString(api.written.PdtContextOverride.Password))
+
+	// clearing settings clears them in Looker
+	delete(override, "password")
+	delete(override, "after_connect_statements")
+
+	newState, diags = applyTestResourceChange(t, resourceConnection(), newState, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	written = api.written.PdtContextOverride
+	if assert.NotNil(t, written) {
+		assert.Equal(t, "", *written.Password)
+		assert.Equal(t, "", *written.AfterConnectStatements)
+	}
+
+	// removing the override deletes it
+	delete(config, "pdt_context_override")
+
+	newState, diags = applyTestResourceChange(t, resourceConnection(), newState, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"pdt"}, api.deletedOverrides)
+	assert.Nil(t, api.written.PdtContextOverride)
+	assert.Equal(t, "0", newState.Attributes["pdt_context_override.#"])
+}
+
+func TestConnectionPdtContextOverrideForceNew(t *testing.T) {
+	for _, key := range []string{"username", "password", "certificate", "file_type"} {
+		assert.False(t, resourceConnection().Schema["pdt_context_override"].Elem.(*schema.Resource).Schema[key].ForceNew, key)
+	}
+}
+
+func TestConnectionSecretRotation(t *testing.T) {
+	api := &mockConnectionAPI{}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"name":             "test_conn",
+		"host":             "warehouse.example.com",
+		"username":         "looker",
+		"password":         "old-secret",
+		"password_version": "1",
+		"database":         "analytics",
+		"dialect_name":     "postgres",
+	}
+
+	state, diags := applyTestResourceChange(t, resourceConnection(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "old-secret", derefString(api.written.Password))
+
+	// changing the password alone isn't detected, and other changes don't resend it
+	config["password"] = "new-secret"
+	config["database"] = "reporting"
+
+	state, diags = applyTestResourceChange(t, resourceConnection(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "reporting", derefString(api.written.Database))
+	assert.Nil(t, api.written.Password)
+	assert.Empty(t, api.tests)
+
+	// bumping the version sends the password in place and tests it
+	config["password_version"] = "2"
+	api.testResults = `[{"name": "connect", "status": "error", "message": "password authentication failed"}]`
+
+	newState, diags := applyTestResourceChange(t, resourceConnection(), state, config, client)
+	assert.Equal(t, "new-secret", derefString(api.written.Password))
+	assert.Equal(t, []string{`"connect"`}, api.tests)
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "password authentication failed", diags[0].Detail)
+	}
+	assert.Equal(t, state.ID, newState.ID)
+}
+
+func TestConnectionCertificateRotation(t *testing.T) {
+	api := &mockConnectionAPI{testResults: `[{"name": "connect", "status": "success"}]`}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"name":                "test_conn",
+		"host":                "test_project",
+		"username":            "test@testproject.iam.gserviceaccount.com",
+		"certificate":         "b2xkLWtleQ==",
+		"certificate_version": "1",
+		"file_type":           ".json",
+		"database":            "test_dataset",
+		"dialect_name":        "bigquery_standard_sql",
+	}
+
+	state, diags := applyTestResourceChange(t, resourceConnection(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "b2xkLWtleQ==", derefString(api.written.Certificate))
+	assert.Equal(t, hash("b2xkLWtleQ=="), state.Attributes["certificate"])
+
+	// other changes don't send the stored hash as the certificate
+	config["database"] = "reporting"
+
+	state, diags = applyTestResourceChange(t, resourceConnection(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Nil(t, api.written.Certificate)
+
+	// bumping the version resends the certificate and tests it
+	config["certificate_version"] = "2"
+
+	_, diags = applyTestResourceChange(t, resourceConnection(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "b2xkLWtleQ==", derefString(api.written.Certificate))
+	assert.Equal(t, ".json", derefString(api.written.FileType))
+	assert.Equal(t, []string{`"connect"`}, api.tests)
+}
+
+// testDialects is the dialect_info of the dialects used in the connection tests.
+const testDialects = `[
+	{"name": "postgres", "supported_options": {"additional_params": true, "auth": true, "host": true, "oauth_credentials": false, "schema": true, "ssl": true, "timezone": true, "tmp_table": true}},
+	{"name": "bigquery_standard_sql", "supported_options": {"additional_params": true, "auth": false, "host": true, "oauth_credentials": true, "project_name": true, "schema": false, "ssl": false, "timezone": true, "tmp_table": true}},
+	{"name": "snowflake", "supported_options": {"additional_params": true, "auth": true, "host": true, "oauth_credentials": true, "schema": true, "ssl": true, "timezone": true, "tmp_table": true}}
+]`
+
+// mockConnectionAPI stores the last connection written to it and returns it
+// without its write-only fields, like the Looker API does. Connection tests
+// return testResults, and connections have the given dialect.
+type mockConnectionAPI struct {
+	written          apiclient.WriteDBConnection
+	connection       apiclient.DBConnection
+	tests            []string
+	testResults      string
+	deletedOverrides []string
+	dialect          *apiclient.Dialect
+}
+
+func (api *mockConnectionAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/4.0/dialect_info" {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, testDialects)
+		return
+	}
+
+	if r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "/test") {
+		api.tests = append(api.tests, r.URL.Query().Get("tests"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, api.testResults)
+		return
+	}
+
+	if r.Method == http.MethodDelete && strings.Contains(r.URL.Path, "/connection_override/") {
+		api.deletedOverrides = append(api.deletedOverrides, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+		api.connection.PdtContextOverride = nil
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `""`)
+		return
+	}
+
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		api.written = apiclient.WriteDBConnection{}
+		if err := json.NewDecoder(r.Body).Decode(&api.written); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		b, _ := json.Marshal(api.written)
+		api.connection = apiclient.DBConnection{}
+		_ = json.Unmarshal(b, &api.connection)
+		api.connection.Password = nil
+		api.connection.Certificate = nil
+		api.connection.FileType = nil
+		if override := api.connection.PdtContextOverride; override != nil {
+			override.Password = nil
+			override.Certificate = nil
+			override.FileType = nil
+		}
+	}
+
+	api.connection.Dialect = api.dialect
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(api.connection)
+}
+
+func TestConnectionTestDiagnostics(t *testing.T) {
+	connect, query, kill := "connect", "query", "kill"
+	success, errorStatus, skipped := "success", "error", "skipped"
+	message := "Cannot connect: invalid credentials"
+
+	tests := map[string]struct {
+		results       []apiclient.DBConnectionTestResult
+		wantSummaries []string
+	}{
+		"all passed": {
+			results: []apiclient.DBConnectionTestResult{
+				{Name: &connect, Status: &success},
+				{Name: &kill, Status: &skipped},
+			},
+		},
+		"one failed": {
+			results: []apiclient.DBConnectionTestResult{
+				{Name: &connect, Status: &errorStatus, Message: &message},
+				{Name: &query, Status: &success},
+			},
+			wantSummaries: []string{"Connection test_conn failed the connect test"},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			diags := connectionTestDiagnostics("test_conn", tt.results)
+			var summaries []string
+			for _, d := range diags {
+				summaries = append(summaries, d.Summary)
+				assert.Equal(t, message, d.Detail)
+			}
+			assert.Equal(t, tt.wantSummaries, summaries)
+		})
+	}
+}
+
 func connectionConfig(name string) string {
 	return fmt.Sprintf(`
 	resource "looker_connection" "test" {
@@ -94,3 +380,398 @@ func connectionConfig(name string) string {
 	}
 	`, name)
 }
+
+func TestValidateConnectionDialect(t *testing.T) {
+	var dialects []apiclient.DialectInfo
+	if err := json.Unmarshal([]byte(testDialects), &dialects); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		dialectName string
+		config      map[string]interface{}
+		wantErr     string
+	}{
+		"supported options": {
+			dialectName: "postgres",
+			config: map[string]interface{}{
+				"password":    "secret",
+				"schema":      "public",
+				"ssl":         true,
+				"tmp_db_name": "looker_scratch",
+			},
+		},
+		"unknown dialect": {
+			dialectName: "postgresql",
+			wantErr:     `unknown dialect "postgresql"`,
+		},
+		"unsupported options": {
+			dialectName: "bigquery_standard_sql",
+			config: map[string]interface{}{
+				"password":    "secret",
+				"schema":      "public",
+				"tmp_db_name": "looker_scratch",
+			},
+			wantErr: "dialect bigquery_standard_sql doesn't support password, schema",
+		},
+		"unsupported option not set": {
+			dialectName: "bigquery_standard_sql",
+			config: map[string]interface{}{
+				"ssl": false,
+			},
+		},
+		"certificate without service account support": {
+			dialectName: "postgres",
+			config: map[string]interface{}{
+				"certificate": "Y2VydGlmaWNhdGU=",
+				"file_type":   ".p12",
+			},
+			wantErr: "dialect postgres doesn't support certificate, file_type",
+		},
+		"oauth application": {
+			dialectName: "postgres",
+			config: map[string]interface{}{
+				"oauth_application_id": "1",
+			},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceConnection().Schema, tt.config)
+			err := validateConnectionDialect(d, tt.dialectName, dialects)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateConnectionDialectFeatures(t *testing.T) {
+	yes, no := true, false
+
+	tests := map[string]struct {
+		dialect apiclient.Dialect
+		config  map[string]interface{}
+		wantErr string
+	}{
+		"supported features": {
+			dialect: apiclient.Dialect{SupportsCostEstimate: &yes, SupportsPersistentDerivedTables: &yes},
+			config: map[string]interface{}{
+				"max_billing_gigabytes": "100",
+				"pdt_concurrency":       2,
+			},
+		},
+		"unsupported features": {
+			dialect: apiclient.Dialect{SupportsCostEstimate: &no, SupportsPersistentDerivedTables: &no},
+			config: map[string]interface{}{
+				"max_billing_gigabytes": "100",
+				"pdt_concurrency":       2,
+			},
+			wantErr: "dialect postgres doesn't support max_billing_gigabytes, pdt_concurrency",
+		},
+		"unsupported features not set": {
+			dialect: apiclient.Dialect{SupportsCostEstimate: &no, SupportsPersistentDerivedTables: &no},
+		},
+		"unknown features": {
+			config: map[string]interface{}{
+				"max_billing_gigabytes": "100",
+			},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceConnection().Schema, tt.config)
+			err := validateConnectionDialectFeatures(d, "postgres", tt.dialect)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConnectionCustomizeDiff(t *testing.T) {
+	api := &mockConnectionAPI{}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"name":         "test_conn",
+		"host":         "test_project",
+		"username":     "test@testproject.iam.gserviceaccount.com",
+		"password":     "secret",
+		"database":     "test_dataset",
+		"dialect_name": "bigquery_standard_sql",
+	}
+
+	_, err := resourceConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "dialect bigquery_standard_sql doesn't support password")
+	}
+
+	delete(config, "password")
+
+	_, err = resourceConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+
+	// required attributes only known after apply are assumed to be set
+	config["host"] = unknownValue
+
+	_, err = resourceConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+
+	delete(config, "host")
+
+	_, err = resourceConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `"host" is required`)
+	}
+
+	// the features of the dialect are checked once the connection exists
+	no := false
+	api.dialect = &apiclient.Dialect{SupportsCostEstimate: &no}
+	config["host"] = "test_project"
+
+	state, diags := applyTestResourceChange(t, resourceConnection(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+
+	config["max_billing_gigabytes"] = "100"
+
+	_, err = resourceConnection().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "dialect bigquery_standard_sql doesn't support max_billing_gigabytes")
+	}
+}
+
+func TestConnectionDialectBlocks(t *testing.T) {
+	serviceAccountJSON := `{"type": "service_account", "client_email": "looker@test-project.iam.gserviceaccount.com"}`
+
+	tests := map[string]struct {
+		config map[string]interface{}
+		want   map[string]string
+	}{
+		"bigquery": {
+			config: map[string]interface{}{
+				"name":         "test_conn",
+				"dialect_name": "bigquery_standard_sql",
+				"tmp_db_name":  "looker_scratch",
+				"bigquery": []interface{}{map[string]interface{}{
+					"project":               "test-project",
+					"dataset":               "analytics",
+					"service_account_json":  serviceAccountJSON,
+					"max_billing_gigabytes": "100",
+				}},
+			},
+			want: map[string]string{
+				"Host":                "test-project",
+				"Database":            "analytics",
+				"Username":            "looker@test-project.iam.gserviceaccount.com",
+				"Certificate":         base64.StdEncoding.EncodeToString([]byte(serviceAccountJSON)),
+				"FileType":            ".json",
+				"MaxBillingGigabytes": "100",
+				"TmpDbName":           "looker_scratch",
+			},
+		},
+		"snowflake": {
+			config: map[string]interface{}{
+				"name":                   "test_conn",
+				"dialect_name":           "snowflake",
+				"username":               "LOOKER",
+				"database":               "ANALYTICS",
+				"jdbc_additional_params": "CLIENT_SESSION_KEEP_ALIVE=true",
+				"snowflake": []interface{}{map[string]interface{}{
+					"account":   "xy12345.us-east-1",
+					"warehouse": "LOOKER_WH",
+					"role":      "LOOKER_ROLE",
+				}},
+			},
+			want: map[string]string{
+				"Host":                 "xy12345.us-east-1.snowflakecomputing.com",
+				"Database":             "ANALYTICS",
+				"Username":             "LOOKER",
+				"JdbcAdditionalParams": "account=xy12345.us-east-1&warehouse=LOOKER_WH&role=LOOKER_ROLE&CLIENT_SESSION_KEEP_ALIVE=true",
+			},
+		},
+		"postgres": {
+			config: map[string]interface{}{
+				"name":         "test_conn",
+				"dialect_name": "postgres",
+				"username":     "looker",
+				"postgres": []interface{}{map[string]interface{}{
+					"host":     "warehouse.example.com",
+					"database": "analytics",
+					"ssl":      true,
+				}},
+			},
+			want: map[string]string{
+				"Host":     "warehouse.example.com",
+				"Port":     "5432",
+				"Database": "analytics",
+				"Username": "looker",
+				"Schema":   "public",
+			},
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			api := &mockConnectionAPI{}
+			client := newTestClient(t, api.ServeHTTP)
+
+			state, diags := applyTestResourceChange(t, resourceConnection(), nil, tt.config, client)
+			assert.False(t, diags.HasError(), "%v", diags)
+
+			written := api.written
+			got := map[string]string{
+				"Host":                 derefString(written.Host),
+				"Port":                 derefString(written.Port),
+				"Database":             derefString(written.Database),
+				"Username":             derefString(written.Username),
+				"Schema":               derefString(written.Schema),
+				"Certificate":          derefString(written.Certificate),
+				"FileType":             derefString(written.FileType),
+				"MaxBillingGigabytes":  derefString(written.MaxBillingGigabytes),
+				"TmpDbName":            derefString(written.TmpDbName),
+				"JdbcAdditionalParams": derefString(written.JdbcAdditionalParams),
+			}
+			for k, v := range got {
+				if v == "" {
+					delete(got, k)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+
+			// the blocks are read back from the API without a diff
+			diff, err := resourceConnection().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), client)
+			assert.NoError(t, err)
+			assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+		})
+	}
+}
+
+// knownResourceData is resource data whose values are all known, like the
+// configuration of ResourceDiff in plans without values computed on apply.
+type knownResourceData struct {
+	*schema.ResourceData
+}
+
+func (knownResourceData) NewValueKnown(string) bool {
+	return true
+}
+
+func TestValidateConnectionDialectBlocks(t *testing.T) {
+	tests := map[string]struct {
+		dialectName string
+		config      map[string]interface{}
+		wantErr     string
+	}{
+		"flat attributes": {
+			dialectName: "postgres",
+			config:      map[string]interface{}{"host": "db", "database": "analytics", "username": "looker"},
+		},
+		"missing host": {
+			dialectName: "postgres",
+			config:      map[string]interface{}{"database": "analytics", "username": "looker"},
+			wantErr:     `"host" is required`,
+		},
+		"snowflake sets the host": {
+			dialectName: "snowflake",
+			config: map[string]interface{}{
+				"database":  "ANALYTICS",
+				"username":  "LOOKER",
+				"snowflake": []interface{}{map[string]interface{}{"account": "xy12345"}},
+			},
+		},
+		"block of another dialect": {
+			dialectName: "snowflake",
+			config: map[string]interface{}{
+				"username": "looker",
+				"postgres": []interface{}{map[string]interface{}{"host": "db", "database": "analytics"}},
+			},
+			wantErr: "postgres block can't be used with dialect snowflake",
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			d := knownResourceData{schema.TestResourceDataRaw(t, resourceConnection().Schema, tt.config)}
+			err := validateConnectionDialectBlocks(d, tt.dialectName)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConnectionCertificateFile(t *testing.T) {
+	api := &mockConnectionAPI{testResults: `[{"name": "connect", "status": "success"}]`}
+	client := newTestClient(t, api.ServeHTTP)
+
+	path := filepath.Join(t.TempDir(), "sa-key")
+	if err := os.WriteFile(path, []byte(`{"private_key_id": "old"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := map[string]interface{}{
+		"name":             "test_conn",
+		"host":             "test_project",
+		"username":         "test@testproject.iam.gserviceaccount.com",
+		"certificate_file": path,
+		"database":         "test_dataset",
+		"dialect_name":     "bigquery_standard_sql",
+	}
+
+	state, diags := applyTestResourceChange(t, resourceConnection(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(`{"private_key_id": "old"}`)), derefString(api.written.Certificate))
+	assert.Equal(t, ".json", derefString(api.written.FileType))
+	assert.Equal(t, hash(`{"private_key_id": "old"}`), state.Attributes["certificate_file"])
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "Certificate of connection test_conn is not refreshed", diags[0].Summary)
+	}
+
+	// an unchanged file isn't sent again
+	config["database"] = "reporting"
+
+	state, diags = applyTestResourceChange(t, resourceConnection(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Nil(t, api.written.Certificate)
+
+	// replacing the file sends its new content
+	if err := os.WriteFile(path, []byte(`{"private_key_id": "new"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	newState, diags := applyTestResourceChange(t, resourceConnection(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(`{"private_key_id": "new"}`)), derefString(api.written.Certificate))
+	assert.Equal(t, hash(`{"private_key_id": "new"}`), newState.Attributes["certificate_file"])
+	assert.Equal(t, state.ID, newState.ID)
+}
+
+func TestDetectCertificateFileType(t *testing.T) {
+	tests := map[string]struct {
+		path    string
+		content string
+		want    string
+	}{
+		"json extension": {path: "sa.json", content: "{}", want: ".json"},
+		"p12 extension":  {path: "key.P12", content: "\x30\x82", want: ".p12"},
+		"pfx extension":  {path: "key.pfx", content: "\x30\x82", want: ".p12"},
+		"json content":   {path: "sa-key", content: `{"type": "service_account"}`, want: ".json"},
+		"binary content": {path: "key", content: "\x30\x82\x09\xc1", want: ".p12"},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectCertificateFileType(tt.path, []byte(tt.content)))
+		})
+	}
+}
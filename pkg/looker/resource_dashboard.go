@@ -0,0 +1,692 @@
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// dashboardDefinition is the document accepted by the `definition` attribute
+// of looker_dashboard. Keys that aren't listed here are rejected, except for
+// the IDs Looker generates for elements, filters, queries and layouts, which
+// are accepted so that exported dashboards can be used as is, but ignored.
+type dashboardDefinition struct {
+	Filters  []dashboardDefinitionFilter  `json:"filters,omitempty"`
+	Elements []dashboardDefinitionElement `json:"elements,omitempty"`
+}
+
+type dashboardDefinitionFilter struct {
+	ID                  string                 `json:"id,omitempty"`
+	DashboardID         string                 `json:"dashboard_id,omitempty"`
+	Name                string                 `json:"name"`
+	Title               string                 `json:"title,omitempty"`
+	Type                string                 `json:"type,omitempty"`
+	DefaultValue        string                 `json:"default_value,omitempty"`
+	Model               string                 `json:"model,omitempty"`
+	Explore             string                 `json:"explore,omitempty"`
+	Dimension           string                 `json:"dimension,omitempty"`
+	ListensToFilters    []string               `json:"listens_to_filters,omitempty"`
+	AllowMultipleValues bool                   `json:"allow_multiple_values,omitempty"`
+	Required            bool                   `json:"required,omitempty"`
+	UiConfig            map[string]interface{} `json:"ui_config,omitempty"`
+}
+
+type dashboardDefinitionElement struct {
+	ID           string                     `json:"id,omitempty"`
+	DashboardID  string                     `json:"dashboard_id,omitempty"`
+	QueryID      string                     `json:"query_id,omitempty"`
+	Type         string                     `json:"type,omitempty"`
+	Title        string                     `json:"title,omitempty"`
+	TitleHidden  bool                       `json:"title_hidden,omitempty"`
+	TitleText    string                     `json:"title_text,omitempty"`
+	SubtitleText string                     `json:"subtitle_text,omitempty"`
+	BodyText     string                     `json:"body_text,omitempty"`
+	NoteText     string                     `json:"note_text,omitempty"`
+	NoteDisplay  string                     `json:"note_display,omitempty"`
+	NoteState    string                     `json:"note_state,omitempty"`
+	Query        *dashboardDefinitionQuery  `json:"query,omitempty"`
+	Layout       *dashboardDefinitionLayout `json:"layout,omitempty"`
+}
+
+type dashboardDefinitionQuery struct {
+	ID         string                 `json:"id,omitempty"`
+	Model      string                 `json:"model"`
+	View       string                 `json:"view"`
+	Fields     []string               `json:"fields,omitempty"`
+	Pivots     []string               `json:"pivots,omitempty"`
+	FillFields []string               `json:"fill_fields,omitempty"`
+	Filters    map[string]interface{} `json:"filters,omitempty"`
+	Sorts      []string               `json:"sorts,omitempty"`
+	Limit      string                 `json:"limit,omitempty"`
+	Total      bool                   `json:"total,omitempty"`
+	VisConfig  map[string]interface{} `json:"vis_config,omitempty"`
+}
+
+type dashboardDefinitionLayout struct {
+	ID     string `json:"id,omitempty"`
+	Row    int64  `json:"row"`
+	Column int64  `json:"column"`
+	Width  int64  `json:"width"`
+	Height int64  `json:"height"`
+}
+
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDashboardCreate,
+		ReadContext:   resourceDashboardRead,
+		UpdateContext: resourceDashboardUpdate,
+		DeleteContext: resourceDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceDashboardCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"definition": {
+				Type: schema.TypeString,
+				Description: "JSON document with the dashboard `filters` and `elements` (each with an optional " +
+					"embedded `query` and `layout`). Unknown keys are rejected. IDs generated by Looker are " +
+					"ignored, as are the element `type`, `note_display`, `note_state` and `layout`, the filter " +
+					"`ui_config` and the query `vis_config` Looker fills in when they are not in the document.",
+				Required:         true,
+				ValidateFunc:     validateDashboardDefinition,
+				DiffSuppressFunc: suppressEquivalentDashboardDefinition,
+			},
+			"element_ids": {
+				Type:        schema.TypeList,
+				Description: "IDs of the dashboard elements, in the order of `elements` in `definition`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	definition, err := expandDashboardDefinition(d.Get("definition").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Create dashboard %s", d.Get("title").(string))
+
+	dashboard, err := client.CreateDashboard(expandWriteDashboard(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*dashboard.Id)
+
+	if err = syncDashboardDefinition(client, dashboard, definition); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	dashboard, err := client.Dashboard(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if dashboard.Deleted != nil && *dashboard.Deleted {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("title", dashboard.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("folder_id", dashboard.FolderId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", dashboard.Description); err != nil {
+		return diag.FromErr(err)
+	}
+
+	definition, err := flattenDashboardDefinition(dashboard)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("definition", definition); err != nil {
+		return diag.FromErr(err)
+	}
+
+	elementIDs := make([]string, 0)
+	for _, element := range sortedDashboardElements(dashboard) {
+		elementIDs = append(elementIDs, *element.Id)
+	}
+	if err = d.Set("element_ids", elementIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	dashboardID := d.Id()
+
+	if d.HasChanges("title", "folder_id", "description") {
+		log.Printf("[DEBUG] Update dashboard %s", dashboardID)
+
+		_, err := client.UpdateDashboard(dashboardID, expandWriteDashboard(d), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("definition") {
+		definition, err := expandDashboardDefinition(d.Get("definition").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		dashboard, err := client.Dashboard(dashboardID, "", nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = syncDashboardDefinition(client, dashboard, definition); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDashboardRead(ctx, d, m)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	dashboardID := d.Id()
+
+	log.Printf("[DEBUG] Delete dashboard %s", dashboardID)
+
+	_, err := client.DeleteDashboard(dashboardID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceDashboardCustomizeDiff marks element_ids as unknown when elements
+// are added or removed. Elements are reconciled by position, so the IDs of
+// existing elements don't change otherwise.
+func resourceDashboardCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("definition") {
+		return nil
+	}
+
+	o, n := d.GetChange("definition")
+	oldDefinition, err := expandDashboardDefinition(o.(string))
+	if err != nil {
+		return nil
+	}
+	newDefinition, err := expandDashboardDefinition(n.(string))
+	if err != nil {
+		return nil
+	}
+	if len(oldDefinition.Elements) != len(newDefinition.Elements) {
+		return d.SetNewComputed("element_ids")
+	}
+	return nil
+}
+
+func expandWriteDashboard(d *schema.ResourceData) apiclient.WriteDashboard {
+	title := d.Get("title").(string)
+	folderID := d.Get("folder_id").(string)
+	description := d.Get("description").(string)
+
+	return apiclient.WriteDashboard{
+		Title:       &title,
+		FolderId:    &folderID,
+		Description: &description,
+	}
+}
+
+func validateDashboardDefinition(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	if _, err := expandDashboardDefinition(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %v", k, err))
+	}
+	return warnings, errors
+}
+
+// expandDashboardDefinition decodes a definition, rejecting unknown keys so
+// that misspelled settings aren't silently dropped. The IDs generated by
+// Looker are cleared.
+func expandDashboardDefinition(raw string) (*dashboardDefinition, error) {
+	var definition dashboardDefinition
+	decoder := json.NewDecoder(strings.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&definition); err != nil {
+		return nil, fmt.Errorf("invalid dashboard definition: %v", err)
+	}
+	for i := range definition.Filters {
+		filter := &definition.Filters[i]
+		if filter.Name == "" {
+			return nil, fmt.Errorf("invalid dashboard definition: filters[%d] has no name", i)
+		}
+		filter.ID, filter.DashboardID = "", ""
+	}
+	for i := range definition.Elements {
+		element := &definition.Elements[i]
+		element.ID, element.DashboardID, element.QueryID = "", "", ""
+		if element.Query != nil {
+			element.Query.ID = ""
+		}
+		if element.Layout != nil {
+			element.Layout.ID = ""
+		}
+	}
+	return &definition, nil
+}
+
+// syncDashboardDefinition reconciles the filters and elements of an existing
+// dashboard with the definition. Filters are matched by name and elements by
+// position, so unchanged elements keep their IDs (and any alerts set on them).
+func syncDashboardDefinition(client *apiclient.LookerSDK, dashboard apiclient.Dashboard, definition *dashboardDefinition) error {
+	dashboardID := *dashboard.Id
+
+	existingFilters := make(map[string]apiclient.DashboardFilter)
+	if dashboard.DashboardFilters != nil {
+		for _, filter := range *dashboard.DashboardFilters {
+			existingFilters[*filter.Name] = filter
+		}
+	}
+	for i, filter := range definition.Filters {
+		row := int64(i)
+		body := expandWriteDashboardFilter(filter, row)
+		if existing, ok := existingFilters[filter.Name]; ok {
+			delete(existingFilters, filter.Name)
+			if _, err := client.UpdateDashboardFilter(*existing.Id, body, "", nil); err != nil {
+				return err
+			}
+			continue
+		}
+		createBody := apiclient.WriteCreateDashboardFilter{
+			DashboardId:         dashboardID,
+			Name:                filter.Name,
+			Title:               filter.Title,
+			Type:                filter.Type,
+			DefaultValue:        body.DefaultValue,
+			Model:               body.Model,
+			Explore:             body.Explore,
+			Dimension:           body.Dimension,
+			Row:                 body.Row,
+			ListensToFilters:    body.ListensToFilters,
+			AllowMultipleValues: body.AllowMultipleValues,
+			Required:            body.Required,
+			UiConfig:            body.UiConfig,
+		}
+		if _, err := client.CreateDashboardFilter(createBody, "", nil); err != nil {
+			return err
+		}
+	}
+	for _, filter := range existingFilters {
+		if _, err := client.DeleteDashboardFilter(*filter.Id, nil); err != nil {
+			return err
+		}
+	}
+
+	existingElements := sortedDashboardElements(dashboard)
+	elementIDs := make([]string, len(definition.Elements))
+	for i, element := range definition.Elements {
+		body := expandWriteDashboardElement(element, dashboardID)
+		if i < len(existingElements) {
+			result, err := client.UpdateDashboardElement(*existingElements[i].Id, body, "", nil)
+			if err != nil {
+				return err
+			}
+			elementIDs[i] = *result.Id
+			continue
+		}
+		result, err := client.CreateDashboardElement(apiclient.RequestCreateDashboardElement{Body: body}, nil)
+		if err != nil {
+			return err
+		}
+		elementIDs[i] = *result.Id
+	}
+	for i := len(definition.Elements); i < len(existingElements); i++ {
+		if _, err := client.DeleteDashboardElement(*existingElements[i].Id, nil); err != nil {
+			return err
+		}
+	}
+
+	// the layout components only exist once the elements do
+	dashboard, err := client.Dashboard(dashboardID, "", nil)
+	if err != nil {
+		return err
+	}
+	components := activeDashboardLayoutComponents(dashboard)
+	for i, element := range definition.Elements {
+		if element.Layout == nil {
+			continue
+		}
+		component, ok := components[elementIDs[i]]
+		if !ok {
+			return fmt.Errorf("no layout component found for dashboard element %s", elementIDs[i])
+		}
+		body := apiclient.WriteDashboardLayoutComponent{
+			Row:    &element.Layout.Row,
+			Column: &element.Layout.Column,
+			Width:  &element.Layout.Width,
+			Height: &element.Layout.Height,
+		}
+		if _, err := client.UpdateDashboardLayoutComponent(*component.Id, body, "", nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expandWriteDashboardFilter sends every setting, so that settings removed
+// from the definition are cleared, except ui_config which Looker fills in.
+func expandWriteDashboardFilter(filter dashboardDefinitionFilter, row int64) apiclient.WriteDashboardFilter {
+	listensToFilters := filter.ListensToFilters
+	if listensToFilters == nil {
+		listensToFilters = []string{}
+	}
+	body := apiclient.WriteDashboardFilter{
+		Name:                &filter.Name,
+		Title:               &filter.Title,
+		Type:                &filter.Type,
+		DefaultValue:        &filter.DefaultValue,
+		Model:               &filter.Model,
+		Explore:             &filter.Explore,
+		Dimension:           &filter.Dimension,
+		Row:                 &row,
+		ListensToFilters:    &listensToFilters,
+		AllowMultipleValues: &filter.AllowMultipleValues,
+		Required:            &filter.Required,
+	}
+	if filter.UiConfig != nil {
+		body.UiConfig = &filter.UiConfig
+	}
+	return body
+}
+
+// expandWriteDashboardElement sends every setting, so that settings removed
+// from the definition are cleared, except the ones Looker has a default for.
+func expandWriteDashboardElement(element dashboardDefinitionElement, dashboardID string) apiclient.WriteDashboardElement {
+	body := apiclient.WriteDashboardElement{
+		DashboardId:  &dashboardID,
+		Title:        &element.Title,
+		TitleHidden:  &element.TitleHidden,
+		TitleText:    &element.TitleText,
+		SubtitleText: &element.SubtitleText,
+		BodyText:     &element.BodyText,
+		NoteText:     &element.NoteText,
+	}
+	if element.Type != "" {
+		body.Type = &element.Type
+	}
+	if element.NoteDisplay != "" {
+		body.NoteDisplay = &element.NoteDisplay
+	}
+	if element.NoteState != "" {
+		body.NoteState = &element.NoteState
+	}
+	if element.Query != nil {
+		body.Query = expandDashboardDefinitionQuery(element.Query)
+	}
+	return body
+}
+
+func expandDashboardDefinitionQuery(query *dashboardDefinitionQuery) *apiclient.WriteQuery {
+	writeQuery := &apiclient.WriteQuery{
+		Model: query.Model,
+		View:  query.View,
+		Total: &query.Total,
+	}
+	if query.Fields != nil {
+		writeQuery.Fields = &query.Fields
+	}
+	if query.Pivots != nil {
+		writeQuery.Pivots = &query.Pivots
+	}
+	if query.FillFields != nil {
+		writeQuery.FillFields = &query.FillFields
+	}
+	if query.Filters != nil {
+		writeQuery.Filters = &query.Filters
+	}
+	if query.Sorts != nil {
+		writeQuery.Sorts = &query.Sorts
+	}
+	if query.Limit != "" {
+		writeQuery.Limit = &query.Limit
+	}
+	if query.VisConfig != nil {
+		writeQuery.VisConfig = &query.VisConfig
+	}
+	return writeQuery
+}
+
+func flattenDashboardDefinition(dashboard apiclient.Dashboard) (string, error) {
+	var definition dashboardDefinition
+
+	var filters []apiclient.DashboardFilter
+	if dashboard.DashboardFilters != nil {
+		filters = append(filters, *dashboard.DashboardFilters...)
+	}
+	sort.SliceStable(filters, func(i, j int) bool {
+		return derefInt64(filters[i].Row) < derefInt64(filters[j].Row)
+	})
+	for _, filter := range filters {
+		f := dashboardDefinitionFilter{
+			Name:                derefString(filter.Name),
+			Title:               derefString(filter.Title),
+			Type:                derefString(filter.Type),
+			DefaultValue:        derefString(filter.DefaultValue),
+			Model:               derefString(filter.Model),
+			Explore:             derefString(filter.Explore),
+			Dimension:           derefString(filter.Dimension),
+			AllowMultipleValues: derefBool(filter.AllowMultipleValues),
+			Required:            derefBool(filter.Required),
+		}
+		if filter.ListensToFilters != nil {
+			f.ListensToFilters = *filter.ListensToFilters
+		}
+		if filter.UiConfig != nil {
+			f.UiConfig = *filter.UiConfig
+		}
+		definition.Filters = append(definition.Filters, f)
+	}
+
+	components := activeDashboardLayoutComponents(dashboard)
+	for _, element := range sortedDashboardElements(dashboard) {
+		e := dashboardDefinitionElement{
+			Type:         derefString(element.Type),
+			Title:        derefString(element.Title),
+			TitleHidden:  derefBool(element.TitleHidden),
+			TitleText:    derefString(element.TitleText),
+			SubtitleText: derefString(element.SubtitleText),
+			BodyText:     derefString(element.BodyText),
+			NoteText:     derefString(element.NoteText),
+			NoteDisplay:  derefString(element.NoteDisplay),
+			NoteState:    derefString(element.NoteState),
+		}
+		if element.Query != nil {
+			e.Query = flattenDashboardDefinitionQuery(*element.Query)
+		}
+		if component, ok := components[*element.Id]; ok {
+			e.Layout = &dashboardDefinitionLayout{
+				Row:    derefInt64(component.Row),
+				Column: derefInt64(component.Column),
+				Width:  derefInt64(component.Width),
+				Height: derefInt64(component.Height),
+			}
+		}
+		definition.Elements = append(definition.Elements, e)
+	}
+
+	b, err := json.Marshal(definition)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func flattenDashboardDefinitionQuery(query apiclient.Query) *dashboardDefinitionQuery {
+	q := &dashboardDefinitionQuery{
+		Model: query.Model,
+		View:  query.View,
+		Limit: derefString(query.Limit),
+		Total: derefBool(query.Total),
+	}
+	if query.Fields != nil {
+		q.Fields = *query.Fields
+	}
+	if query.Pivots != nil {
+		q.Pivots = *query.Pivots
+	}
+	if query.FillFields != nil {
+		q.FillFields = *query.FillFields
+	}
+	if query.Filters != nil {
+		q.Filters = *query.Filters
+	}
+	if query.Sorts != nil {
+		q.Sorts = *query.Sorts
+	}
+	if query.VisConfig != nil {
+		q.VisConfig = *query.VisConfig
+	}
+	return q
+}
+
+// sortedDashboardElements returns the elements of a dashboard in creation
+// order, which is the order they are listed in the definition.
+func sortedDashboardElements(dashboard apiclient.Dashboard) []apiclient.DashboardElement {
+	var elements []apiclient.DashboardElement
+	if dashboard.DashboardElements != nil {
+		elements = append(elements, *dashboard.DashboardElements...)
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		a, errA := strconv.ParseInt(*elements[i].Id, 10, 64)
+		b, errB := strconv.ParseInt(*elements[j].Id, 10, 64)
+		if errA != nil || errB != nil {
+			return *elements[i].Id < *elements[j].Id
+		}
+		return a < b
+	})
+	return elements
+}
+
+// activeDashboardLayoutComponents returns the components of the active layout
+// of a dashboard keyed by dashboard element ID.
+func activeDashboardLayoutComponents(dashboard apiclient.Dashboard) map[string]apiclient.DashboardLayoutComponent {
+	components := make(map[string]apiclient.DashboardLayoutComponent)
+	if dashboard.DashboardLayouts == nil {
+		return components
+	}
+	for _, layout := range *dashboard.DashboardLayouts {
+		if !derefBool(layout.Active) || layout.DashboardLayoutComponents == nil {
+			continue
+		}
+		for _, component := range *layout.DashboardLayoutComponents {
+			if component.DashboardElementId != nil {
+				components[*component.DashboardElementId] = component
+			}
+		}
+	}
+	return components
+}
+
+// suppressEquivalentDashboardDefinition hides the diff when the configured
+// definition matches the dashboard in Looker, once the settings Looker fills
+// in on its own are dropped from the dashboard.
+func suppressEquivalentDashboardDefinition(k, old, new string, d *schema.ResourceData) bool {
+	oldDefinition, err := expandDashboardDefinition(old)
+	if err != nil {
+		return false
+	}
+	newDefinition, err := expandDashboardDefinition(new)
+	if err != nil {
+		return false
+	}
+	removeDashboardDefinitionDefaults(oldDefinition, newDefinition)
+
+	oldJSON, err := json.Marshal(oldDefinition)
+	if err != nil {
+		return false
+	}
+	newJSON, err := json.Marshal(newDefinition)
+	if err != nil {
+		return false
+	}
+	return string(oldJSON) == string(newJSON)
+}
+
+// removeDashboardDefinitionDefaults clears the settings of the dashboard that
+// Looker fills in when they aren't set in the configured definition: the type,
+// note placement and layout of elements, the ui_config of filters and the
+// vis_config of queries. Filters and elements are matched by position.
+func removeDashboardDefinitionDefaults(dashboard, config *dashboardDefinition) {
+	for i := range dashboard.Filters {
+		if i >= len(config.Filters) {
+			break
+		}
+		if len(config.Filters[i].UiConfig) == 0 {
+			dashboard.Filters[i].UiConfig = nil
+		}
+	}
+	for i := range dashboard.Elements {
+		if i >= len(config.Elements) {
+			break
+		}
+		element, configElement := &dashboard.Elements[i], config.Elements[i]
+		if configElement.Type == "" {
+			element.Type = ""
+		}
+		if configElement.NoteDisplay == "" {
+			element.NoteDisplay = ""
+		}
+		if configElement.NoteState == "" {
+			element.NoteState = ""
+		}
+		if configElement.Layout == nil {
+			element.Layout = nil
+		}
+		if element.Query != nil && configElement.Query != nil && len(configElement.Query.VisConfig) == 0 {
+			element.Query.VisConfig = nil
+		}
+	}
+}
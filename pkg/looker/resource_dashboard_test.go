@@ -0,0 +1,197 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Dashboard(t *testing.T) {
+	title1 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	title2 := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: dashboardConfig(title1, "Users"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_dashboard.test", "title", title1),
+					resource.TestCheckResourceAttr("looker_dashboard.test", "folder_id", "1"),
+				),
+			},
+			{
+				Config: dashboardConfig(title2, "Active users"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_dashboard.test", "title", title2),
+				),
+			},
+			{
+				ResourceName:            "looker_dashboard.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+		},
+		CheckDestroy: testAccCheckDashboardDestroy,
+	})
+}
+
+func TestSuppressEquivalentDashboardDefinition(t *testing.T) {
+	tests := map[string]struct {
+		old     string
+		new     string
+		wantRes bool
+	}{
+		"identical": {
+			old:     `{"elements":[{"title":"a","query":{"model":"m","view":"v"}}]}`,
+			new:     `{"elements":[{"title":"a","query":{"model":"m","view":"v"}}]}`,
+			wantRes: true,
+		},
+		"ids are ignored": {
+			old:     `{"elements":[{"title":"a","query":{"model":"m","view":"v"}}]}`,
+			new:     `{"elements":[{"id":"12","query_id":"34","title":"a","query":{"id":"34","model":"m","view":"v"}}]}`,
+			wantRes: true,
+		},
+		"defaults added by looker are ignored": {
+			old:     `{"filters":[{"name":"f","ui_config":{"type":"advanced"}}],"elements":[{"type":"vis","title":"a","note_display":"above","query":{"model":"m","view":"v","vis_config":{"type":"table"}},"layout":{"row":0,"column":0,"width":8,"height":6}}]}`,
+			new:     `{"filters":[{"name":"f"}],"elements":[{"title":"a","query":{"model":"m","view":"v"}}]}`,
+			wantRes: true,
+		},
+		"changed value": {
+			old:     `{"elements":[{"title":"a","query":{"model":"m","view":"v","vis_config":{"type":"table"}}}]}`,
+			new:     `{"elements":[{"title":"a","query":{"model":"m","view":"v","vis_config":{"type":"looker_pie"}}}]}`,
+			wantRes: false,
+		},
+		"removed element": {
+			old:     `{"elements":[{"title":"a"},{"title":"b"}]}`,
+			new:     `{"elements":[{"title":"a"}]}`,
+			wantRes: false,
+		},
+		"removed filter": {
+			old:     `{"filters":[{"name":"f"},{"name":"g"}]}`,
+			new:     `{"filters":[{"name":"f"}]}`,
+			wantRes: false,
+		},
+		"removed vis_config key": {
+			old:     `{"elements":[{"query":{"model":"m","view":"v","vis_config":{"type":"table","show_row_numbers":true}}}]}`,
+			new:     `{"elements":[{"query":{"model":"m","view":"v","vis_config":{"type":"table"}}}]}`,
+			wantRes: false,
+		},
+		"filter no longer required": {
+			old:     `{"filters":[{"name":"f","required":true,"allow_multiple_values":true}]}`,
+			new:     `{"filters":[{"name":"f"}]}`,
+			wantRes: false,
+		},
+		"title shown again": {
+			old:     `{"elements":[{"title":"a","title_hidden":true}]}`,
+			new:     `{"elements":[{"title":"a","title_hidden":false}]}`,
+			wantRes: false,
+		},
+		"cleared string": {
+			old:     `{"elements":[{"title":"a","note_text":"b"}]}`,
+			new:     `{"elements":[{"title":"a","note_text":""}]}`,
+			wantRes: false,
+		},
+		"unknown key": {
+			old:     `{"elements":[{"title":"a"}]}`,
+			new:     `{"elements":[{"title":"a","titel":"b"}]}`,
+			wantRes: false,
+		},
+		"new resource": {
+			old:     "",
+			new:     `{"elements":[{"title":"a"}]}`,
+			wantRes: false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			actual := suppressEquivalentDashboardDefinition("definition", tt.old, tt.new, nil)
+			assert.Equal(t, tt.wantRes, actual)
+		})
+	}
+}
+
+func TestExpandDashboardDefinition(t *testing.T) {
+	definition, err := expandDashboardDefinition(`{"elements":[{"id":"12","query_id":"34","title":"a","query":{"id":"34","model":"m","view":"v"}}]}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &dashboardDefinition{
+			Elements: []dashboardDefinitionElement{{
+				Title: "a",
+				Query: &dashboardDefinitionQuery{Model: "m", View: "v"},
+			}},
+		}, definition)
+	}
+
+	_, err = expandDashboardDefinition(`{"elements":[{"title":"a","titel":"b"}]}`)
+	assert.EqualError(t, err, `invalid dashboard definition: json: unknown field "titel"`)
+
+	_, err = expandDashboardDefinition(`{"filters":[{"title":"a"}]}`)
+	assert.EqualError(t, err, "invalid dashboard definition: filters[0] has no name")
+}
+
+func testAccCheckDashboardDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_dashboard" {
+			continue
+		}
+
+		dashboard, err := client.Dashboard(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		if dashboard.Deleted == nil || !*dashboard.Deleted {
+			return fmt.Errorf("dashboard still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func dashboardConfig(title, tileTitle string) string {
+	return fmt.Sprintf(`
+	resource "looker_dashboard" "test" {
+		title     = "%s"
+		folder_id = "1"
+		definition = jsonencode({
+			filters = [{
+				name      = "created_date"
+				title     = "Created Date"
+				type      = "field_filter"
+				model     = "system__activity"
+				explore   = "user"
+				dimension = "user.created_date"
+			}]
+			elements = [{
+				title = "%s"
+				type  = "vis"
+				query = {
+					model  = "system__activity"
+					view   = "user"
+					fields = ["user.count"]
+				}
+				layout = {
+					row    = 0
+					column = 0
+					width  = 8
+					height = 6
+				}
+			}]
+		})
+	}
+	`, title, tileTitle)
+}
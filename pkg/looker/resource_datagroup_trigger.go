@@ -0,0 +1,144 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceDatagroupTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDatagroupTriggerCreate,
+		ReadContext:   resourceDatagroupTriggerRead,
+		UpdateContext: resourceDatagroupTriggerUpdate,
+		DeleteContext: resourceDatagroupTriggerDelete,
+
+		Schema: map[string]*schema.Schema{
+			"model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the datagroup in the model.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Type: schema.TypeMap,
+				Description: "Arbitrary values that, when changed, mark the caches of the datagroup as stale and trigger it, " +
+					"rebuilding the persistent derived tables that depend on it, e.g. the version of a schema migration. " +
+					"The datagroup is also triggered when the resource is created.",
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"stale_before": {
+				Type:        schema.TypeInt,
+				Description: "UNIX timestamp before which cache entries are considered stale.",
+				Computed:    true,
+			},
+			"triggered_at": {
+				Type:        schema.TypeInt,
+				Description: "UNIX timestamp at which the datagroup was last triggered.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDatagroupTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	modelName := d.Get("model_name").(string)
+	name := d.Get("name").(string)
+
+	datagroups, err := client.AllDatagroups(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var datagroupID string
+	for _, datagroup := range datagroups {
+		if derefString(datagroup.ModelName) == modelName && derefString(datagroup.Name) == name {
+			datagroupID = *datagroup.Id
+			break
+		}
+	}
+	if datagroupID == "" {
+		return diag.FromErr(fmt.Errorf("datagroup %s not found in model %s", name, modelName))
+	}
+
+	if err = triggerDatagroup(client, datagroupID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(datagroupID)
+
+	return resourceDatagroupTriggerRead(ctx, d, m)
+}
+
+func resourceDatagroupTriggerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	datagroup, err := client.Datagroup(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("model_name", datagroup.ModelName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", datagroup.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("stale_before", datagroup.StaleBefore); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("triggered_at", datagroup.TriggeredAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDatagroupTriggerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	if d.HasChange("triggers") {
+		if err := triggerDatagroup(client, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDatagroupTriggerRead(ctx, d, m)
+}
+
+func resourceDatagroupTriggerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the datagroup is defined in LookML, so there's nothing to delete
+	log.Printf("[DEBUG] Remove trigger of datagroup %s from state", d.Id())
+	return nil
+}
+
+func triggerDatagroup(client *apiclient.LookerSDK, datagroupID string) error {
+	// neither timestamp may be in the future
+	now := time.Now().Unix()
+
+	log.Printf("[DEBUG] Trigger datagroup %s at %d", datagroupID, now)
+
+	_, err := client.UpdateDatagroup(datagroupID, apiclient.WriteDatagroup{
+		StaleBefore: &now,
+		TriggeredAt: &now,
+	}, nil)
+	return err
+}
@@ -0,0 +1,46 @@
+package looker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DatagroupTrigger(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: datagroupTriggerConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("looker_datagroup_trigger.test", "id", "data.looker_datagroups.datagroup_trigger_test", "datagroups.0.id"),
+					resource.TestCheckResourceAttrSet("looker_datagroup_trigger.test", "triggered_at"),
+					resource.TestCheckResourceAttrSet("looker_datagroup_trigger.test", "stale_before"),
+				),
+			},
+			{
+				Config: datagroupTriggerConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_datagroup_trigger.test", "triggers.migration", "2"),
+				),
+			},
+		},
+	})
+}
+
+func datagroupTriggerConfig(migration string) string {
+	return fmt.Sprintf(`
+	data "looker_datagroups" "datagroup_trigger_test" {
+		model_name = "system__activity"
+	}
+	resource "looker_datagroup_trigger" "test" {
+		model_name = data.looker_datagroups.datagroup_trigger_test.datagroups[0].model_name
+		name       = data.looker_datagroups.datagroup_trigger_test.datagroups[0].name
+		triggers = {
+			migration = "%s"
+		}
+	}
+	`, migration)
+}
@@ -0,0 +1,198 @@
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const pdtBuildSource = "terraform"
+
+type pdtBuildStatus int
+
+const (
+	pdtBuildRunning pdtBuildStatus = iota
+	pdtBuildComplete
+	pdtBuildFailed
+)
+
+func resourceDerivedTableRebuild() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDerivedTableRebuildCreate,
+		ReadContext:   resourceDerivedTableRebuildRead,
+		DeleteContext: resourceDerivedTableRebuildDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"view_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the view of the persistent derived table.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"force_rebuild": {
+				Type:        schema.TypeBool,
+				Description: "Rebuild the PDTs this one depends on, even if they are already materialized.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"force_full_incremental": {
+				Type:        schema.TypeBool,
+				Description: "Fully rebuild incremental PDTs instead of only appending new data.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"workspace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "production",
+				ValidateFunc: validation.StringInSlice([]string{"production", "dev"}, false),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that rebuild the PDT when changed, e.g. the ID of a schema migration.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"materialization_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build_log": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDerivedTableRebuildCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	modelName := d.Get("model_name").(string)
+	viewName := d.Get("view_name").(string)
+	forceRebuild := fmt.Sprintf("%t", d.Get("force_rebuild").(bool))
+	forceFullIncremental := fmt.Sprintf("%t", d.Get("force_full_incremental").(bool))
+	workspace := d.Get("workspace").(string)
+	source := pdtBuildSource
+
+	log.Printf("[DEBUG] Start PDT build of %s in model %s", viewName, modelName)
+
+	materialization, err := client.StartPdtBuild(apiclient.RequestStartPdtBuild{
+		ModelName:            modelName,
+		ViewName:             viewName,
+		ForceRebuild:         &forceRebuild,
+		ForceFullIncremental: &forceFullIncremental,
+		Workspace:            &workspace,
+		Source:               &source,
+	}, nil)
+	if err != nil {
+		return diag.Errorf("PDT build of %s did not start: %v", viewName, err)
+	}
+	if materialization.MaterializationId == nil {
+		return diag.Errorf("PDT build of %s did not start: %s", viewName, derefString(materialization.RespText))
+	}
+
+	materializationID := *materialization.MaterializationId
+	buildLog := derefString(materialization.RespText)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		status, err := client.CheckPdtBuild(materializationID, nil)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		buildLog = derefString(status.RespText)
+
+		buildStatus, err := parsePdtBuildStatus(buildLog)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("PDT build %s: %v", materializationID, err))
+		}
+		switch buildStatus {
+		case pdtBuildComplete:
+			return nil
+		case pdtBuildFailed:
+			return resource.NonRetryableError(fmt.Errorf("PDT build %s failed", materializationID))
+		default:
+			return resource.RetryableError(fmt.Errorf("PDT build %s is still running", materializationID))
+		}
+	})
+	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			log.Printf("[DEBUG] Stop PDT build %s", materializationID)
+			if _, stopErr := client.StopPdtBuild(materializationID, source, nil); stopErr != nil {
+				log.Printf("[WARN] Failed to stop PDT build %s: %v", materializationID, stopErr)
+			}
+		}
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  err.Error(),
+				Detail:   buildLog,
+			},
+		}
+	}
+
+	d.SetId(materializationID)
+
+	if err = d.Set("materialization_id", materializationID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("build_log", buildLog); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDerivedTableRebuildRead(ctx, d, m)
+}
+
+func resourceDerivedTableRebuildRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the build is a one-off action, so there's nothing to refresh
+	return nil
+}
+
+func resourceDerivedTableRebuildDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// the materialized table is managed by Looker, so there's nothing to delete
+	log.Printf("[DEBUG] Remove PDT build %s from state", d.Id())
+	return nil
+}
+
+// parsePdtBuildStatus reads the status of a PDT build from the `status` of
+// the JSON object returned by CheckPdtBuild. Responses that can't be parsed or
+// have an unknown status are errors, rather than waiting for the timeout.
+func parsePdtBuildStatus(respText string) (pdtBuildStatus, error) {
+	var resp struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(respText), &resp); err != nil {
+		return pdtBuildFailed, fmt.Errorf("unexpected status response: %s", respText)
+	}
+
+	switch strings.ToLower(resp.Status) {
+	case "running", "pending", "queued", "enqueued", "started", "building", "in_progress":
+		return pdtBuildRunning, nil
+	case "complete", "completed", "success", "done":
+		return pdtBuildComplete, nil
+	case "error", "failed", "failure", "stopped", "cancelled", "canceled":
+		return pdtBuildFailed, nil
+	default:
+		return pdtBuildFailed, fmt.Errorf("unknown status %q in response: %s", resp.Status, respText)
+	}
+}
@@ -0,0 +1,164 @@
+package looker
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_DerivedTableRebuild(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      derivedTableRebuildConfig(),
+				ExpectError: regexp.MustCompile("PDT build of user did not start"),
+			},
+		},
+	})
+}
+
+func TestParsePdtBuildStatus(t *testing.T) {
+	tests := map[string]struct {
+		respText string
+		wantRes  pdtBuildStatus
+		wantErr  string
+	}{
+		"running": {
+			respText: `{"status": "running"}`,
+			wantRes:  pdtBuildRunning,
+		},
+		"complete": {
+			respText: `{"status": "complete"}`,
+			wantRes:  pdtBuildComplete,
+		},
+		"success": {
+			respText: `{"status": "Success"}`,
+			wantRes:  pdtBuildComplete,
+		},
+		"error": {
+			respText: `{"status": "error", "log": "table does not exist"}`,
+			wantRes:  pdtBuildFailed,
+		},
+		"stopped": {
+			respText: `{"status": "stopped"}`,
+			wantRes:  pdtBuildFailed,
+		},
+		"unknown status": {
+			respText: `{"status": "paused", "log": "no error so far"}`,
+			wantErr:  `unknown status "paused" in response: {"status": "paused", "log": "no error so far"}`,
+		},
+		"no status": {
+			respText: `{"log": "Regeneration failed"}`,
+			wantErr:  `unknown status "" in response: {"log": "Regeneration failed"}`,
+		},
+		"text log": {
+			respText: "Regenerating orders_rollup",
+			wantErr:  "unexpected status response: Regenerating orders_rollup",
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			actual, err := parsePdtBuildStatus(tt.respText)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRes, actual)
+		})
+	}
+}
+
+// mockPdtBuildAPI starts a PDT build and answers its status checks with
+// statuses in order, repeating the last one.
+type mockPdtBuildAPI struct {
+	statuses []string
+	checks   int
+}
+
+func (api *mockPdtBuildAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := "42"
+	var respText string
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/start"):
+		respText = `{"status": "enqueued"}`
+	case strings.HasSuffix(r.URL.Path, "/status"):
+		respText = api.statuses[len(api.statuses)-1]
+		if api.checks < len(api.statuses) {
+			respText = api.statuses[api.checks]
+		}
+		api.checks++
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(apiclient.MaterializePDT{MaterializationId: &id, RespText: &respText})
+}
+
+func TestDerivedTableRebuildPolling(t *testing.T) {
+	config := map[string]interface{}{
+		"model_name": "ecommerce",
+		"view_name":  "orders_rollup",
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		api := &mockPdtBuildAPI{statuses: []string{
+			`{"status": "running", "log": "Regenerating orders_rollup"}`,
+			`{"status": "complete", "log": "Regenerating orders_rollup\nDone"}`,
+		}}
+		client := newTestClient(t, api.ServeHTTP)
+
+		state, diags := applyTestResourceChange(t, resourceDerivedTableRebuild(), nil, config, client)
+		assert.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, 2, api.checks)
+		assert.Equal(t, "42", state.ID)
+		assert.Equal(t, api.statuses[1], state.Attributes["build_log"])
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		api := &mockPdtBuildAPI{statuses: []string{
+			`{"status": "running", "log": "Regenerating orders_rollup"}`,
+			`{"status": "error", "log": "Regenerating orders_rollup\ntable does not exist"}`,
+		}}
+		client := newTestClient(t, api.ServeHTTP)
+
+		_, diags := applyTestResourceChange(t, resourceDerivedTableRebuild(), nil, config, client)
+		assert.Equal(t, 2, api.checks)
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "PDT build 42 failed", diags[0].Summary)
+			assert.Equal(t, api.statuses[1], diags[0].Detail)
+		}
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		api := &mockPdtBuildAPI{statuses: []string{`{"status": "paused"}`}}
+		client := newTestClient(t, api.ServeHTTP)
+
+		_, diags := applyTestResourceChange(t, resourceDerivedTableRebuild(), nil, config, client)
+		assert.Equal(t, 1, api.checks)
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, `PDT build 42: unknown status "paused" in response: {"status": "paused"}`, diags[0].Summary)
+		}
+	})
+}
+
+// system__activity has no persistent derived tables, so the build can't start
+func derivedTableRebuildConfig() string {
+	return `
+	resource "looker_derived_table_rebuild" "test" {
+		model_name = "system__activity"
+		view_name  = "user"
+		timeouts {
+			create = "1m"
+		}
+	}
+	`
+}
@@ -0,0 +1,148 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// The Looker API can only create and list external OAuth applications, so
+// every change replaces the application and destroying it only removes it
+// from the state.
+func resourceExternalOauthApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceExternalOauthApplicationCreate,
+		ReadContext:   resourceExternalOauthApplicationRead,
+		DeleteContext: resourceExternalOauthApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the application. For Snowflake connections, this must be the name of the host database.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "OAuth client ID issued by the database.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"client_secret": {
+				Type: schema.TypeString,
+				Description: "OAuth client secret issued by the database. It is only sent to Looker when the " +
+					"application is created, since the Looker API doesn't return it.",
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"client_secret_version": {
+				Type: schema.TypeString,
+				Description: "Arbitrary value that replaces the application with one using the current " +
+					"`client_secret` when changed, e.g. to rotate it. The Looker API can't delete the " +
+					"replaced application, so it must be deleted in the Looker admin panel.",
+				Optional: true,
+				ForceNew: true,
+			},
+			"dialect_name": {
+				Type:        schema.TypeString,
+				Description: "Dialect of the connections using the application, e.g. `snowflake`.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExternalOauthApplicationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	name := d.Get("name").(string)
+	clientID := d.Get("client_id").(string)
+	clientSecret := d.Get("client_secret").(string)
+	dialectName := d.Get("dialect_name").(string)
+
+	body := apiclient.WriteExternalOauthApplication{
+		Name:         &name,
+		ClientId:     &clientID,
+		ClientSecret: &clientSecret,
+		DialectName:  &dialectName,
+	}
+
+	log.Printf("[DEBUG] Create external OAuth application %s", name)
+
+	application, err := client.CreateExternalOauthApplication(body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*application.Id)
+
+	return resourceExternalOauthApplicationRead(ctx, d, m)
+}
+
+func resourceExternalOauthApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	applications, err := client.AllExternalOauthApplications(apiclient.RequestAllExternalOauthApplications{}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var application *apiclient.ExternalOauthApplication
+	for i := range applications {
+		if derefString(applications[i].Id) == d.Id() {
+			application = &applications[i]
+			break
+		}
+	}
+	if application == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("name", application.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("client_id", application.ClientId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("dialect_name", application.DialectName); err != nil {
+		return diag.FromErr(err)
+	}
+	if application.CreatedAt != nil {
+		if err = d.Set("created_at", application.CreatedAt.Format(time.RFC3339)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceExternalOauthApplicationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Remove external OAuth application %s from state", d.Id())
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("External OAuth application %s was not deleted", d.Id()),
+			Detail: "The Looker API doesn't support deleting external OAuth applications. It was removed " +
+				"from the state, but must be deleted in the Looker admin panel.",
+		},
+	}
+}
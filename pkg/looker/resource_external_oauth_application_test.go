@@ -0,0 +1,114 @@
+package looker
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_ExternalOauthApplication(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: externalOauthApplicationConfig(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_external_oauth_application.test", "name", name),
+					resource.TestCheckResourceAttr("looker_external_oauth_application.test", "dialect_name", "snowflake"),
+					resource.TestCheckResourceAttrSet("looker_external_oauth_application.test", "created_at"),
+				),
+			},
+			{
+				ResourceName:            "looker_external_oauth_application.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret", "client_secret_version"},
+			},
+		},
+	})
+}
+
+func externalOauthApplicationConfig(name, clientSecretVersion string) string {
+	return fmt.Sprintf(`
+	resource "looker_external_oauth_application" "test" {
+		name                  = "%s"
+		client_id             = "test_client_id"
+		client_secret         = "test_client_secret"
+		client_secret_version = "%s"
+		dialect_name          = "snowflake"
+	}
+	`, name, clientSecretVersion)
+}
+
+func TestExternalOauthApplicationLifecycle(t *testing.T) {
+	var applications []apiclient.ExternalOauthApplication
+	var written []apiclient.WriteExternalOauthApplication
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var body apiclient.WriteExternalOauthApplication
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			written = append(written, body)
+
+			id := fmt.Sprintf("%d", len(written))
+			applications = append(applications, apiclient.ExternalOauthApplication{
+				Id:          &id,
+				Name:        body.Name,
+				ClientId:    body.ClientId,
+				DialectName: body.DialectName,
+			})
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(applications[len(applications)-1])
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(applications)
+	})
+
+	config := map[string]interface{}{
+		"name":                  "ANALYTICS",
+		"client_id":             "client_id",
+		"client_secret":         "old-secret",
+		"client_secret_version": "1",
+		"dialect_name":          "snowflake",
+	}
+
+	state, diags := applyTestResourceChange(t, resourceExternalOauthApplication(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "1", state.ID)
+	assert.Equal(t, "old-secret", derefString(written[0].ClientSecret))
+
+	// changing the secret alone isn't detected
+	config["client_secret"] = "new-secret"
+
+	newState, diags := applyTestResourceChange(t, resourceExternalOauthApplication(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "1", newState.ID)
+	assert.Len(t, written, 1)
+
+	// bumping the version replaces the application with the new secret
+	config["client_secret_version"] = "2"
+
+	newState, diags = applyTestResourceChange(t, resourceExternalOauthApplication(), state, config, client)
+	assert.Equal(t, "2", newState.ID)
+	assert.Equal(t, "new-secret", derefString(written[1].ClientSecret))
+	// the replaced application can't be deleted through the API
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, "External OAuth application 1 was not deleted", diags[0].Summary)
+	}
+}
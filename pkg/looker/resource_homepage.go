@@ -0,0 +1,159 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// landingPageUserAttribute is the system user attribute Looker uses to decide
+// which page a user lands on after logging in.
+const landingPageUserAttribute = "landing_page"
+
+func resourceHomepage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHomepageCreate,
+		ReadContext:   resourceHomepageRead,
+		UpdateContext: resourceHomepageUpdate,
+		DeleteContext: resourceHomepageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"board_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the board members of the group land on after logging in.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceHomepageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	groupID := d.Get("group_id").(string)
+
+	if err := updateHomepage(client, groupID, d.Get("board_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(groupID)
+
+	return resourceHomepageRead(ctx, d, m)
+}
+
+func resourceHomepageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	groupID := d.Id()
+
+	userAttributeID, err := findLandingPageUserAttributeID(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userAttributeGroupValues, err := client.AllUserAttributeGroupValues(userAttributeID, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var userAttributeGroupValue *apiclient.UserAttributeGroupValue
+	for _, groupValue := range userAttributeGroupValues {
+		if derefString(groupValue.GroupId) == groupID {
+			userAttributeGroupValue = &groupValue
+			break
+		}
+	}
+	if userAttributeGroupValue == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("group_id", groupID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("board_id", strings.TrimPrefix(derefString(userAttributeGroupValue.Value), "/boards/")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceHomepageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	if err := updateHomepage(client, d.Id(), d.Get("board_id").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHomepageRead(ctx, d, m)
+}
+
+func resourceHomepageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	groupID := d.Id()
+
+	userAttributeID, err := findLandingPageUserAttributeID(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Delete homepage of group %s", groupID)
+
+	err = client.DeleteUserAttributeGroupValue(groupID, userAttributeID, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "EOF") {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func updateHomepage(client *apiclient.LookerSDK, groupID, boardID string) error {
+	userAttributeID, err := findLandingPageUserAttributeID(client)
+	if err != nil {
+		return err
+	}
+
+	value := "/boards/" + boardID
+
+	log.Printf("[DEBUG] Set homepage of group %s to %s", groupID, value)
+
+	body := apiclient.UserAttributeGroupValue{
+		GroupId:         &groupID,
+		UserAttributeId: &userAttributeID,
+		Value:           &value,
+	}
+	_, err = client.UpdateUserAttributeGroupValue(groupID, userAttributeID, body, nil)
+	return err
+}
+
+func findLandingPageUserAttributeID(client *apiclient.LookerSDK) (string, error) {
+	userAttributes, err := client.AllUserAttributes(apiclient.RequestAllBoardSections{}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	for _, userAttribute := range userAttributes {
+		if userAttribute.Name == landingPageUserAttribute {
+			return *userAttribute.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("user attribute %s not found", landingPageUserAttribute)
+}
@@ -0,0 +1,56 @@
+package looker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Homepage(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: homepageConfig(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("looker_homepage.test", "group_id", "looker_group.homepage_test", "id"),
+					resource.TestCheckResourceAttrPair("looker_homepage.test", "board_id", "looker_board.first", "id"),
+				),
+			},
+			{
+				Config: homepageConfig(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("looker_homepage.test", "board_id", "looker_board.second", "id"),
+				),
+			},
+			{
+				ResourceName:      "looker_homepage.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func homepageConfig(name, board string) string {
+	return fmt.Sprintf(`
+	resource "looker_group" "homepage_test" {
+		name = "%s"
+	}
+	resource "looker_board" "first" {
+		title = "%s first"
+	}
+	resource "looker_board" "second" {
+		title = "%s second"
+	}
+	resource "looker_homepage" "test" {
+		group_id = looker_group.homepage_test.id
+		board_id = looker_board.%s.id
+	}
+	`, name, name, name, board)
+}
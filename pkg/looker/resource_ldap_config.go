@@ -0,0 +1,672 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// ldapConfigID is the ID of the only LDAP configuration of a Looker instance.
+const ldapConfigID = "ldap_config"
+
+func resourceLdapConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLdapConfigCreate,
+		ReadContext:   resourceLdapConfigRead,
+		UpdateContext: resourceLdapConfigUpdate,
+		DeleteContext: resourceLdapConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"connection_host": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"connection_port": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "636",
+			},
+			"connection_tls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"connection_tls_no_verify": {
+				Type:        schema.TypeBool,
+				Description: "Don't verify the certificate of the LDAP server.",
+				Optional:    true,
+			},
+			"auth_username": {
+				Type:        schema.TypeString,
+				Description: "Bind DN of the account Looker uses to search the directory.",
+				Optional:    true,
+			},
+			"auth_password": {
+				Type: schema.TypeString,
+				Description: "Password of `auth_username`. Changes are only sent to Looker when " +
+					"`auth_password_version` changes, since the Looker API doesn't return the password.",
+				Optional:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"auth_password_version": {
+				Type:        schema.TypeString,
+				Description: "Arbitrary value that sends `auth_password` to Looker again when changed, e.g. to rotate it.",
+				Optional:    true,
+			},
+			"user_bind_base_dn": {
+				Type:        schema.TypeString,
+				Description: "Base DN of the user searches.",
+				Required:    true,
+			},
+			"user_custom_filter": {
+				Type:        schema.TypeString,
+				Description: "RFC 2254 filter clause added to the user searches.",
+				Optional:    true,
+			},
+			"user_id_attribute_names": {
+				Type:        schema.TypeString,
+				Description: "Comma-separated attributes matched against the login of users.",
+				Optional:    true,
+				Default:     "uid",
+			},
+			"user_objectclass": {
+				Type:        schema.TypeString,
+				Description: "Object class of the user records.",
+				Optional:    true,
+			},
+			"user_attribute_map_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "mail",
+			},
+			"user_attribute_map_first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "givenName",
+			},
+			"user_attribute_map_last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sn",
+			},
+			"user_attribute_map_ldap_id": {
+				Type:        schema.TypeString,
+				Description: "Attribute uniquely identifying users.",
+				Optional:    true,
+				Default:     "uid",
+			},
+			"groups_base_dn": {
+				Type:        schema.TypeString,
+				Description: "Base DN of the group searches.",
+				Optional:    true,
+			},
+			"groups_finder_type": {
+				Type:        schema.TypeString,
+				Description: "How Looker finds the groups of users.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"groups_member_attribute": {
+				Type:        schema.TypeString,
+				Description: "Group attribute listing the members of groups.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"groups_objectclasses": {
+				Type:        schema.TypeString,
+				Description: "Comma-separated object classes of the group records.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"groups_user_attribute": {
+				Type:        schema.TypeString,
+				Description: "User attribute listed in the members of groups.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"force_no_page": {
+				Type:        schema.TypeBool,
+				Description: "Don't page LDAP search results (RFC 2696).",
+				Optional:    true,
+			},
+			"merge_new_users_by_email": {
+				Type:        schema.TypeBool,
+				Description: "Merge existing users by email address on their first LDAP login.",
+				Optional:    true,
+			},
+			"alternate_email_login_allowed": {
+				Type:        schema.TypeBool,
+				Description: "Allow admins and users with the `login_special_email` permission to log in with email and password.",
+				Optional:    true,
+			},
+			"default_new_user_role_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the roles of new users on their first LDAP login.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"default_new_user_group_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the groups of new users on their first LDAP login.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"set_roles_from_groups": {
+				Type:        schema.TypeBool,
+				Description: "Set the roles of users from their LDAP groups, as mapped by `group`.",
+				Optional:    true,
+			},
+			"group": {
+				Type:        schema.TypeSet,
+				Description: "Mappings of LDAP groups to Looker roles.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the group in LDAP.",
+							Required:    true,
+						},
+						"role_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"user_attribute": {
+				Type:        schema.TypeSet,
+				Description: "Mappings of LDAP attributes to Looker user attributes.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the attribute in LDAP.",
+							Required:    true,
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Description: "Whether users can only log in if they have the attribute.",
+							Optional:    true,
+						},
+						"user_attribute_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"auth_requires_role": {
+				Type:        schema.TypeBool,
+				Description: "Only allow users to log in if LDAP grants them a role.",
+				Optional:    true,
+			},
+			"allow_direct_roles": {
+				Type:        schema.TypeBool,
+				Description: "Allow roles to be assigned directly to LDAP users.",
+				Optional:    true,
+			},
+			"allow_normal_group_membership": {
+				Type:        schema.TypeBool,
+				Description: "Allow LDAP users to be members of groups that aren't mapped from LDAP.",
+				Optional:    true,
+			},
+			"allow_roles_from_normal_groups": {
+				Type:        schema.TypeBool,
+				Description: "Let LDAP users inherit the roles of groups that aren't mapped from LDAP.",
+				Optional:    true,
+			},
+			"test_before_enable": {
+				Type: schema.TypeBool,
+				Description: "Test the connection, the authentication of `auth_username` and, if `test_user` is " +
+					"set, the lookup and authentication of a user, before enabling LDAP or changing it while " +
+					"enabled. Changes are only applied if every test passes.",
+				Optional: true,
+				Default:  true,
+			},
+			"test_user": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login": {
+							Type:        schema.TypeString,
+							Description: "Login of the user to look up.",
+							Required:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "Password to authenticate the user with. Only the lookup is tested if empty.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLdapConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	// there's only one LDAP configuration, so creating it adopts it
+	diags := updateLdapConfig(client, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(ldapConfigID)
+
+	return append(diags, resourceLdapConfigRead(ctx, d, m)...)
+}
+
+func resourceLdapConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	ldapConfig, err := client.LdapConfig(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("enabled", ldapConfig.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("connection_host", ldapConfig.ConnectionHost); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("connection_port", ldapConfig.ConnectionPort); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("connection_tls", ldapConfig.ConnectionTls); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("connection_tls_no_verify", ldapConfig.ConnectionTlsNoVerify); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_username", ldapConfig.AuthUsername); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_bind_base_dn", ldapConfig.UserBindBaseDn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_custom_filter", ldapConfig.UserCustomFilter); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_id_attribute_names", ldapConfig.UserIdAttributeNames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_objectclass", ldapConfig.UserObjectclass); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_email", ldapConfig.UserAttributeMapEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_first_name", ldapConfig.UserAttributeMapFirstName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_last_name", ldapConfig.UserAttributeMapLastName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_ldap_id", ldapConfig.UserAttributeMapLdapId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_base_dn", ldapConfig.GroupsBaseDn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_finder_type", ldapConfig.GroupsFinderType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_member_attribute", ldapConfig.GroupsMemberAttribute); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_objectclasses", ldapConfig.GroupsObjectclasses); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_user_attribute", ldapConfig.GroupsUserAttribute); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("force_no_page", ldapConfig.ForceNoPage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("merge_new_users_by_email", ldapConfig.MergeNewUsersByEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("alternate_email_login_allowed", ldapConfig.AlternateEmailLoginAllowed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_new_user_role_ids", flattenDefaultNewUserRoleIDs(ldapConfig.DefaultNewUserRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_new_user_group_ids", flattenDefaultNewUserGroupIDs(ldapConfig.DefaultNewUserGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("set_roles_from_groups", ldapConfig.SetRolesFromGroups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group", flattenLDAPGroups(ldapConfig.GroupsWithRoleIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute", flattenLDAPUserAttributes(ldapConfig.UserAttributesWithIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_requires_role", ldapConfig.AuthRequiresRole); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_direct_roles", ldapConfig.AllowDirectRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_normal_group_membership", ldapConfig.AllowNormalGroupMembership); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_roles_from_normal_groups", ldapConfig.AllowRolesFromNormalGroups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLdapConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	diags := updateLdapConfig(client, d)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceLdapConfigRead(ctx, d, m)...)
+}
+
+func resourceLdapConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	// the LDAP configuration can't be deleted, so it's disabled instead
+	log.Printf("[DEBUG] Disable LDAP")
+
+	enabled := false
+	_, err := client.UpdateLdapConfig(apiclient.WriteLDAPConfig{Enabled: &enabled}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// updateLdapConfig saves the LDAP configuration, after testing it if it's
+// going to be enabled. The warnings of the tests are returned, and nothing is
+// saved if any of them fails.
+func updateLdapConfig(client *apiclient.LookerSDK, d *schema.ResourceData) diag.Diagnostics {
+	body := expandWriteLDAPConfig(d)
+
+	var diags diag.Diagnostics
+	if *body.Enabled && d.Get("test_before_enable").(bool) {
+		diags = testLdapConfig(client, body, d)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	log.Printf("[DEBUG] Update LDAP config")
+
+	if _, err := client.UpdateLdapConfig(body, nil); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// ldapConfigTest is one of the tests of an LDAP configuration, which are run
+// in order since each one relies on the previous ones passing.
+type ldapConfigTest struct {
+	name string
+	run  func(*apiclient.LookerSDK, apiclient.WriteLDAPConfig) (apiclient.LDAPConfigTestResult, error)
+}
+
+// testLdapConfig runs the tests that apply to the LDAP configuration, stopping
+// at the first failure, and returns their results as diagnostics.
+func testLdapConfig(client *apiclient.LookerSDK, body apiclient.WriteLDAPConfig, d *schema.ResourceData) diag.Diagnostics {
+	tests := []ldapConfigTest{
+		{"connection", func(c *apiclient.LookerSDK, b apiclient.WriteLDAPConfig) (apiclient.LDAPConfigTestResult, error) {
+			return c.TestLdapConfigConnection(b, nil)
+		}},
+	}
+	if derefString(body.AuthUsername) != "" {
+		// the password is only saved when it's new or rotated, but the auth
+		// test always needs it
+		if authPassword, ok := configString(d, "auth_password"); ok {
+			body.AuthPassword = &authPassword
+		}
+		tests = append(tests, ldapConfigTest{"auth", func(c *apiclient.LookerSDK, b apiclient.WriteLDAPConfig) (apiclient.LDAPConfigTestResult, error) {
+			return c.TestLdapConfigAuth(b, nil)
+		}})
+	}
+	if testUser := d.Get("test_user").([]interface{}); len(testUser) > 0 && testUser[0] != nil {
+		raw := testUser[0].(map[string]interface{})
+		login := raw["login"].(string)
+		body.TestLdapUser = &login
+		tests = append(tests, ldapConfigTest{"user_info", func(c *apiclient.LookerSDK, b apiclient.WriteLDAPConfig) (apiclient.LDAPConfigTestResult, error) {
+			return c.TestLdapConfigUserInfo(b, nil)
+		}})
+
+		if password := raw["password"].(string); password != "" {
+			body.TestLdapPassword = &password
+			tests = append(tests, ldapConfigTest{"user_auth", func(c *apiclient.LookerSDK, b apiclient.WriteLDAPConfig) (apiclient.LDAPConfigTestResult, error) {
+				return c.TestLdapConfigUserAuth(b, nil)
+			}})
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, test := range tests {
+		log.Printf("[DEBUG] Test LDAP config %s", test.name)
+
+		result, err := test.run(client, body)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		diags = append(diags, ldapConfigTestDiagnostics(test.name, result)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
+
+// ldapConfigTestDiagnostics returns an error unless the LDAP configuration
+// passed the test, and a diagnostic for every issue found by the test.
+func ldapConfigTestDiagnostics(testName string, result apiclient.LDAPConfigTestResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if status := derefString(result.Status); status != "success" {
+		detail := derefString(result.Message)
+		if details := derefString(result.Details); details != "" {
+			detail = strings.TrimSpace(detail + "\n\n" + details)
+		}
+		if detail == "" {
+			detail = fmt.Sprintf("The test returned the status %q.", status)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("LDAP config failed the %s test", testName),
+			Detail:   detail,
+		})
+	}
+	if result.Issues != nil {
+		for _, issue := range *result.Issues {
+			severity := diag.Warning
+			if strings.EqualFold(derefString(issue.Severity), "error") {
+				severity = diag.Error
+			}
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("LDAP config %s test: %s", testName, derefString(issue.Message)),
+			})
+		}
+	}
+	return diags
+}
+
+func expandWriteLDAPConfig(d *schema.ResourceData) apiclient.WriteLDAPConfig {
+	enabled := d.Get("enabled").(bool)
+	connectionHost := d.Get("connection_host").(string)
+	connectionPort := d.Get("connection_port").(string)
+	connectionTLS := d.Get("connection_tls").(bool)
+	connectionTLSNoVerify := d.Get("connection_tls_no_verify").(bool)
+	authUsername := d.Get("auth_username").(string)
+	userBindBaseDN := d.Get("user_bind_base_dn").(string)
+	userCustomFilter := d.Get("user_custom_filter").(string)
+	userIDAttributeNames := d.Get("user_id_attribute_names").(string)
+	userObjectclass := d.Get("user_objectclass").(string)
+	userAttributeMapEmail := d.Get("user_attribute_map_email").(string)
+	userAttributeMapFirstName := d.Get("user_attribute_map_first_name").(string)
+	userAttributeMapLastName := d.Get("user_attribute_map_last_name").(string)
+	userAttributeMapLdapID := d.Get("user_attribute_map_ldap_id").(string)
+	groupsBaseDN := d.Get("groups_base_dn").(string)
+	forceNoPage := d.Get("force_no_page").(bool)
+	mergeNewUsersByEmail := d.Get("merge_new_users_by_email").(bool)
+	alternateEmailLoginAllowed := d.Get("alternate_email_login_allowed").(bool)
+	defaultNewUserRoleIDs := expandStringListFromSet(d.Get("default_new_user_role_ids"))
+	defaultNewUserGroupIDs := expandStringListFromSet(d.Get("default_new_user_group_ids"))
+	setRolesFromGroups := d.Get("set_roles_from_groups").(bool)
+	authRequiresRole := d.Get("auth_requires_role").(bool)
+	allowDirectRoles := d.Get("allow_direct_roles").(bool)
+	allowNormalGroupMembership := d.Get("allow_normal_group_membership").(bool)
+	allowRolesFromNormalGroups := d.Get("allow_roles_from_normal_groups").(bool)
+
+	groups := make([]apiclient.LDAPGroupWrite, 0)
+	for _, v := range d.Get("group").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		roleIDs := expandStringListFromSet(raw["role_ids"])
+		groups = append(groups, apiclient.LDAPGroupWrite{
+			Name:    &name,
+			RoleIds: &roleIDs,
+		})
+	}
+
+	userAttributes := make([]apiclient.LDAPUserAttributeWrite, 0)
+	for _, v := range d.Get("user_attribute").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		required := raw["required"].(bool)
+		userAttributeIDs := expandStringListFromSet(raw["user_attribute_ids"])
+		userAttributes = append(userAttributes, apiclient.LDAPUserAttributeWrite{
+			Name:             &name,
+			Required:         &required,
+			UserAttributeIds: &userAttributeIDs,
+		})
+	}
+
+	writeLDAPConfig := apiclient.WriteLDAPConfig{
+		Enabled:                    &enabled,
+		ConnectionHost:             &connectionHost,
+		ConnectionPort:             &connectionPort,
+		ConnectionTls:              &connectionTLS,
+		ConnectionTlsNoVerify:      &connectionTLSNoVerify,
+		AuthUsername:               &authUsername,
+		UserBindBaseDn:             &userBindBaseDN,
+		UserCustomFilter:           &userCustomFilter,
+		UserIdAttributeNames:       &userIDAttributeNames,
+		UserObjectclass:            &userObjectclass,
+		UserAttributeMapEmail:      &userAttributeMapEmail,
+		UserAttributeMapFirstName:  &userAttributeMapFirstName,
+		UserAttributeMapLastName:   &userAttributeMapLastName,
+		UserAttributeMapLdapId:     &userAttributeMapLdapID,
+		GroupsBaseDn:               &groupsBaseDN,
+		ForceNoPage:                &forceNoPage,
+		MergeNewUsersByEmail:       &mergeNewUsersByEmail,
+		AlternateEmailLoginAllowed: &alternateEmailLoginAllowed,
+		DefaultNewUserRoleIds:      &defaultNewUserRoleIDs,
+		DefaultNewUserGroupIds:     &defaultNewUserGroupIDs,
+		SetRolesFromGroups:         &setRolesFromGroups,
+		GroupsWithRoleIds:          &groups,
+		UserAttributesWithIds:      &userAttributes,
+		AuthRequiresRole:           &authRequiresRole,
+		AllowDirectRoles:           &allowDirectRoles,
+		AllowNormalGroupMembership: &allowNormalGroupMembership,
+		AllowRolesFromNormalGroups: &allowRolesFromNormalGroups,
+	}
+
+	// computed values
+	if v, ok := d.GetOk("groups_finder_type"); ok {
+		groupsFinderType := v.(string)
+		writeLDAPConfig.GroupsFinderType = &groupsFinderType
+	}
+	if v, ok := d.GetOk("groups_member_attribute"); ok {
+		groupsMemberAttribute := v.(string)
+		writeLDAPConfig.GroupsMemberAttribute = &groupsMemberAttribute
+	}
+	if v, ok := d.GetOk("groups_objectclasses"); ok {
+		groupsObjectclasses := v.(string)
+		writeLDAPConfig.GroupsObjectclasses = &groupsObjectclasses
+	}
+	if v, ok := d.GetOk("groups_user_attribute"); ok {
+		groupsUserAttribute := v.(string)
+		writeLDAPConfig.GroupsUserAttribute = &groupsUserAttribute
+	}
+	// the password is write-only and hidden from the diff, so read it from
+	// the configuration and only send it when it's new or rotated
+	if d.IsNewResource() || d.HasChange("auth_password_version") {
+		if authPassword, ok := configString(d, "auth_password"); ok {
+			writeLDAPConfig.AuthPassword = &authPassword
+		}
+	}
+
+	return writeLDAPConfig
+}
+
+func flattenLDAPGroups(groups *[]apiclient.LDAPGroupWrite) []interface{} {
+	if groups == nil {
+		return []interface{}{}
+	}
+	vs := make([]interface{}, 0, len(*groups))
+	for _, group := range *groups {
+		var roleIDs []string
+		if group.RoleIds != nil {
+			roleIDs = *group.RoleIds
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":     derefString(group.Name),
+			"role_ids": flattenStringListToSet(roleIDs),
+		})
+	}
+	return vs
+}
+
+func flattenLDAPUserAttributes(userAttributes *[]apiclient.LDAPUserAttributeWrite) []interface{} {
+	if userAttributes == nil {
+		return []interface{}{}
+	}
+	vs := make([]interface{}, 0, len(*userAttributes))
+	for _, userAttribute := range *userAttributes {
+		var userAttributeIDs []string
+		if userAttribute.UserAttributeIds != nil {
+			userAttributeIDs = *userAttribute.UserAttributeIds
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":               derefString(userAttribute.Name),
+			"required":           derefBool(userAttribute.Required),
+			"user_attribute_ids": flattenStringListToSet(userAttributeIDs),
+		})
+	}
+	return vs
+}
@@ -0,0 +1,165 @@
+package looker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockLdapConfigAPI returns a mock of the LDAP configuration and its
+// tests. Tests pass unless they have a result in results.
+func newMockLdapConfigAPI(results map[string]apiclient.LDAPConfigTestResult) *mockSettingsAPI {
+	status := "success"
+	tests := make(map[string]interface{})
+	for _, test := range []string{"connection", "auth", "user_info", "user_auth"} {
+		result, ok := results[test]
+		if !ok {
+			result = apiclient.LDAPConfigTestResult{Status: &status}
+		}
+		tests["PUT /api/4.0/ldap_config/test_"+test] = result
+	}
+
+	return &mockSettingsAPI{
+		config:    map[string]interface{}{"enabled": false},
+		writeOnly: []string{"auth_password"},
+		tests:     tests,
+	}
+}
+
+func ldapTestConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":               true,
+		"connection_host":       "ldap.example.com",
+		"auth_username":         "cn=looker,dc=example,dc=com",
+		"auth_password":         "secret",
+		"user_bind_base_dn":     "ou=people,dc=example,dc=com",
+		"groups_base_dn":        "ou=groups,dc=example,dc=com",
+		"set_roles_from_groups": true,
+		"group": []interface{}{
+			map[string]interface{}{
+				"name":     "engineers",
+				"role_ids": []interface{}{"3"},
+			},
+		},
+		"test_user": []interface{}{
+			map[string]interface{}{
+				"login":    "jdoe",
+				"password": "jdoe-password",
+			},
+		},
+	}
+}
+
+func TestLdapConfigLifecycle(t *testing.T) {
+	status, warning, message := "success", "warning", "Paging is not supported by the server"
+	api := newMockLdapConfigAPI(map[string]apiclient.LDAPConfigTestResult{
+		"auth": {Status: &status, Issues: &[]apiclient.LDAPConfigTestIssue{{Severity: &warning, Message: &message}}},
+	})
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := ldapTestConfig()
+
+	state, diags := applyTestResourceChange(t, resourceLdapConfig(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, ldapConfigID, state.ID)
+	// every test runs before the configuration is saved
+	assert.Equal(t, []string{
+		"PUT /api/4.0/ldap_config/test_connection",
+		"PUT /api/4.0/ldap_config/test_auth",
+		"PUT /api/4.0/ldap_config/test_user_info",
+		"PUT /api/4.0/ldap_config/test_user_auth",
+		"PATCH /api/4.0/ldap_config",
+		"GET /api/4.0/ldap_config",
+	}, api.calls)
+	assert.Equal(t, "secret", api.testBodies[1]["auth_password"])
+	assert.Equal(t, "jdoe", api.testBodies[3]["test_ldap_user"])
+	assert.Equal(t, "jdoe-password", api.testBodies[3]["test_ldap_password"])
+	// the test user isn't saved
+	assert.NotContains(t, api.config, "test_ldap_user")
+	assert.Equal(t, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "LDAP config auth test: Paging is not supported by the server",
+	}}, diags)
+
+	// reading the configuration back doesn't show any changes
+	diff, err := resourceLdapConfig().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// disabling LDAP doesn't test it
+	api.calls = nil
+	config["enabled"] = false
+
+	state, diags = applyTestResourceChange(t, resourceLdapConfig(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{
+		"PATCH /api/4.0/ldap_config",
+		"GET /api/4.0/ldap_config",
+	}, api.calls)
+
+	// enabling LDAP again tests it with the password, which isn't saved again
+	api.testBodies = nil
+	config["enabled"] = true
+
+	state, diags = applyTestResourceChange(t, resourceLdapConfig(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "secret", api.testBodies[1]["auth_password"])
+	assert.NotContains(t, api.updates[len(api.updates)-1], "auth_password")
+
+	// destroying the resource disables LDAP
+
+	diags = resourceLdapConfig().DeleteContext(context.Background(), resourceLdapConfig().Data(state), client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, false, api.config["enabled"])
+}
+
+func TestLdapConfigFailedTest(t *testing.T) {
+	status, message, details := "error", "User not found", "No entry matched (uid=jdoe)"
+	api := newMockLdapConfigAPI(map[string]apiclient.LDAPConfigTestResult{
+		"user_info": {Status: &status, Message: &message, Details: &details},
+	})
+	client := newTestClient(t, api.ServeHTTP)
+
+	_, diags := applyTestResourceChange(t, resourceLdapConfig(), nil, ldapTestConfig(), client)
+	assert.Equal(t, diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "LDAP config failed the user_info test",
+		Detail:   "User not found\n\nNo entry matched (uid=jdoe)",
+	}}, diags)
+	// the tests stop at the first failure and nothing is saved
+	assert.Equal(t, []string{
+		"PUT /api/4.0/ldap_config/test_connection",
+		"PUT /api/4.0/ldap_config/test_auth",
+		"PUT /api/4.0/ldap_config/test_user_info",
+	}, api.calls)
+	assert.Equal(t, false, api.config["enabled"])
+}
+
+func TestLdapConfigTestDiagnostics(t *testing.T) {
+	message := "Connection refused"
+
+	cases := map[string]struct {
+		status string
+		err    bool
+	}{
+		"success": {status: "success"},
+		"error":   {status: "error", err: true},
+		"unknown": {status: "warning", err: true},
+		"none":    {err: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			result := apiclient.LDAPConfigTestResult{Message: &message}
+			if c.status != "" {
+				result.Status = &c.status
+			}
+			diags := ldapConfigTestDiagnostics("connection", result)
+			assert.Equal(t, c.err, diags.HasError(), "%v", diags)
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceLook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLookCreate,
+		ReadContext:   resourceLookRead,
+		UpdateContext: resourceLookUpdate,
+		DeleteContext: resourceLookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_public": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"query_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the query the look currently points at. Queries are immutable, so this changes whenever `query` does.",
+				Computed:    true,
+			},
+			"query": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"view": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"fields": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"filters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"sorts": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"pivots": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"limit": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"vis_config": {
+							Type:             schema.TypeString,
+							Description:      "Visualization settings as a JSON object. Defaults to the settings Looker fills in, so removing it keeps the current settings.",
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentLookVisConfig,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// suppressEquivalentLookVisConfig hides the diff when vis_config is the same
+// JSON object as in Looker.
+func suppressEquivalentLookVisConfig(k, old, new string, d *schema.ResourceData) bool {
+	var oldVisConfig, newVisConfig interface{}
+	if err := json.Unmarshal([]byte(old), &oldVisConfig); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newVisConfig); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldVisConfig, newVisConfig)
+}
+
+func resourceLookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	writeQuery, err := expandLookQuery(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	query, err := client.CreateQuery(*writeQuery, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	writeLook := expandWriteLookWithQuery(d)
+	writeLook.QueryId = query.Id
+
+	log.Printf("[DEBUG] Create look %s with query %s", *writeLook.Title, *query.Id)
+
+	look, err := client.CreateLook(writeLook, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*look.Id)
+
+	return resourceLookRead(ctx, d, m)
+}
+
+func resourceLookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	look, err := client.Look(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if look.Deleted != nil && *look.Deleted {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("title", look.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", look.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("folder_id", look.FolderId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("is_public", look.Public); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("query_id", look.QueryId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if look.Query != nil {
+		query, err := flattenLookQuery(*look.Query)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("query", query); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceLookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	lookID := d.Id()
+	writeLook := expandWriteLookWithQuery(d)
+
+	// queries can't be modified, so create a new one and re-point the look at it
+	if d.HasChange("query") {
+		writeQuery, err := expandLookQuery(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		query, err := client.CreateQuery(*writeQuery, "", nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		writeLook.QueryId = query.Id
+	}
+
+	log.Printf("[DEBUG] Update look %s", lookID)
+
+	_, err := client.UpdateLook(lookID, writeLook, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceLookRead(ctx, d, m)
+}
+
+func resourceLookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	lookID := d.Id()
+
+	log.Printf("[DEBUG] Delete look %s", lookID)
+
+	_, err := client.DeleteLook(lookID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandWriteLookWithQuery(d *schema.ResourceData) apiclient.WriteLookWithQuery {
+	title := d.Get("title").(string)
+	description := d.Get("description").(string)
+	folderID := d.Get("folder_id").(string)
+	isPublic := d.Get("is_public").(bool)
+
+	return apiclient.WriteLookWithQuery{
+		Title:       &title,
+		Description: &description,
+		FolderId:    &folderID,
+		Public:      &isPublic,
+	}
+}
+
+func expandLookQuery(d *schema.ResourceData) (*apiclient.WriteQuery, error) {
+	writeQuery := &apiclient.WriteQuery{
+		Model: d.Get("query.0.model").(string),
+		View:  d.Get("query.0.view").(string),
+	}
+
+	fields := expandStringList(d.Get("query.0.fields"))
+	writeQuery.Fields = &fields
+
+	if v, ok := d.GetOk("query.0.filters"); ok {
+		filters := v.(map[string]interface{})
+		writeQuery.Filters = &filters
+	}
+	if v, ok := d.GetOk("query.0.sorts"); ok {
+		sorts := expandStringList(v)
+		writeQuery.Sorts = &sorts
+	}
+	if v, ok := d.GetOk("query.0.pivots"); ok {
+		pivots := expandStringList(v)
+		writeQuery.Pivots = &pivots
+	}
+	if v, ok := d.GetOk("query.0.limit"); ok {
+		limit := v.(string)
+		writeQuery.Limit = &limit
+	}
+	if v, ok := d.GetOk("query.0.vis_config"); ok {
+		var visConfig map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &visConfig); err != nil {
+			return nil, fmt.Errorf("invalid vis_config: %v", err)
+		}
+		writeQuery.VisConfig = &visConfig
+	}
+
+	return writeQuery, nil
+}
+
+func flattenLookQuery(query apiclient.Query) ([]map[string]interface{}, error) {
+	q := map[string]interface{}{
+		"model": query.Model,
+		"view":  query.View,
+	}
+	if query.Fields != nil {
+		q["fields"] = flattenStringList(*query.Fields)
+	}
+	if query.Filters != nil {
+		filters := make(map[string]interface{})
+		for k, v := range *query.Filters {
+			filters[k] = fmt.Sprintf("%v", v)
+		}
+		q["filters"] = filters
+	}
+	if query.Sorts != nil {
+		q["sorts"] = flattenStringList(*query.Sorts)
+	}
+	if query.Pivots != nil {
+		q["pivots"] = flattenStringList(*query.Pivots)
+	}
+	if query.Limit != nil {
+		q["limit"] = *query.Limit
+	}
+	if query.VisConfig != nil && len(*query.VisConfig) > 0 {
+		visConfig, err := json.Marshal(*query.VisConfig)
+		if err != nil {
+			return nil, err
+		}
+		q["vis_config"] = string(visConfig)
+	}
+
+	return []map[string]interface{}{q}, nil
+}
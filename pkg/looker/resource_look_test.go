@@ -0,0 +1,187 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_Look(t *testing.T) {
+	title := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	var lookID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: lookConfig(title, "user.count"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_look.test", "title", title),
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.fields.0", "user.count"),
+					testAccCheckLookID("looker_look.test", &lookID),
+				),
+			},
+			// Test: a query change keeps the look
+			{
+				Config: lookConfig(title, "user.created_date"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_look.test", "query.0.fields.0", "user.created_date"),
+					resource.TestCheckResourceAttrPtr("looker_look.test", "id", &lookID),
+				),
+			},
+			{
+				ResourceName:      "looker_look.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckLookDestroy,
+	})
+}
+
+func TestSuppressEquivalentLookVisConfig(t *testing.T) {
+	tests := map[string]struct {
+		old     string
+		new     string
+		wantRes bool
+	}{
+		"identical": {
+			old:     `{"type":"table","show_row_numbers":true}`,
+			new:     `{"show_row_numbers":true,"type":"table"}`,
+			wantRes: true,
+		},
+		"removed": {
+			old:     `{"type":"table"}`,
+			new:     "",
+			wantRes: false,
+		},
+		"changed value": {
+			old:     `{"type":"table"}`,
+			new:     `{"type":"looker_pie"}`,
+			wantRes: false,
+		},
+		"removed key": {
+			old:     `{"type":"table","show_row_numbers":true}`,
+			new:     `{"type":"table"}`,
+			wantRes: false,
+		},
+		"set to false": {
+			old:     `{"type":"table","show_row_numbers":true}`,
+			new:     `{"type":"table","show_row_numbers":false}`,
+			wantRes: false,
+		},
+		"new resource": {
+			old:     "",
+			new:     `{"type":"table"}`,
+			wantRes: false,
+		},
+	}
+
+	for key, tt := range tests {
+		t.Run(key, func(t *testing.T) {
+			actual := suppressEquivalentLookVisConfig("query.0.vis_config", tt.old, tt.new, nil)
+			assert.Equal(t, tt.wantRes, actual)
+		})
+	}
+}
+
+func TestLookVisConfigRemoved(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			"id":                 "1",
+			"title":              "Users",
+			"folder_id":          "1",
+			"query.#":            "1",
+			"query.0.model":      "system__activity",
+			"query.0.view":       "user",
+			"query.0.fields.#":   "1",
+			"query.0.fields.0":   "user.count",
+			"query.0.vis_config": `{"type":"table"}`,
+		},
+	}
+	config := map[string]interface{}{
+		"title":     "Users",
+		"folder_id": "1",
+		"query": []interface{}{
+			map[string]interface{}{
+				"model":  "system__activity",
+				"view":   "user",
+				"fields": []interface{}{"user.count"},
+			},
+		},
+	}
+
+	// removing vis_config keeps the current settings
+	diff, err := resourceLook().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// changing it isn't suppressed
+	config["query"].([]interface{})[0].(map[string]interface{})["vis_config"] = `{"type":"looker_pie"}`
+
+	diff, err = resourceLook().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.False(t, diff == nil || diff.Empty())
+}
+
+func testAccCheckLookID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("look not found from resources: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckLookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_look" {
+			continue
+		}
+
+		look, err := client.Look(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		if look.Deleted == nil || !*look.Deleted {
+			return fmt.Errorf("look still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func lookConfig(title, field string) string {
+	return fmt.Sprintf(`
+	resource "looker_look" "test" {
+		title     = "%s"
+		folder_id = "1"
+		query {
+			model  = "system__activity"
+			view   = "user"
+			fields = ["%s"]
+			limit  = "10"
+			vis_config = jsonencode({
+				type = "table"
+			})
+		}
+	}
+	`, title, field)
+}
@@ -0,0 +1,156 @@
+package looker
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceLookmlDashboardImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLookmlDashboardImportCreate,
+		ReadContext:   resourceLookmlDashboardImportRead,
+		UpdateContext: resourceLookmlDashboardImportUpdate,
+		DeleteContext: resourceLookmlDashboardImportDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lookml_dashboard_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the LookML dashboard to import, in the form `model::dashboard_name`.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"folder_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Description: "Title of the imported dashboard. Defaults to the title of the LookML dashboard.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sync_trigger": {
+				Type: schema.TypeString,
+				Description: "Arbitrary value that re-syncs the imported dashboard with its LookML source when " +
+					"changed, e.g. the commit SHA of the LookML project. Note that Looker syncs every " +
+					"dashboard linked to the same LookML dashboard.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceLookmlDashboardImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	lookmlDashboardID := d.Get("lookml_dashboard_id").(string)
+	folderID := d.Get("folder_id").(string)
+
+	var body apiclient.WriteDashboard
+	if v, ok := d.GetOk("title"); ok {
+		title := v.(string)
+		body.Title = &title
+	}
+
+	log.Printf("[DEBUG] Import LookML dashboard %s into folder %s", lookmlDashboardID, folderID)
+
+	dashboard, err := client.ImportLookmlDashboard(lookmlDashboardID, folderID, body, false, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*dashboard.Id)
+
+	return resourceLookmlDashboardImportRead(ctx, d, m)
+}
+
+func resourceLookmlDashboardImportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	dashboard, err := client.Dashboard(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if dashboard.Deleted != nil && *dashboard.Deleted {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("lookml_dashboard_id", dashboard.LookmlLinkId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("folder_id", dashboard.FolderId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("title", dashboard.Title); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceLookmlDashboardImportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	dashboardID := d.Id()
+	lookmlDashboardID := d.Get("lookml_dashboard_id").(string)
+
+	if d.HasChange("sync_trigger") {
+		log.Printf("[DEBUG] Sync LookML dashboard %s", lookmlDashboardID)
+
+		_, err := client.SyncLookmlDashboard(lookmlDashboardID, apiclient.WriteDashboard{}, false, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// syncing resets the title to the one in LookML, so re-apply a configured one
+	titleIsSet := !d.GetRawConfig().GetAttr("title").IsNull()
+	if d.HasChanges("folder_id", "title") || d.HasChange("sync_trigger") && titleIsSet {
+		folderID := d.Get("folder_id").(string)
+		body := apiclient.WriteDashboard{
+			FolderId: &folderID,
+		}
+		if titleIsSet {
+			title := d.Get("title").(string)
+			body.Title = &title
+		}
+
+		log.Printf("[DEBUG] Update imported dashboard %s", dashboardID)
+
+		_, err := client.UpdateDashboard(dashboardID, body, nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLookmlDashboardImportRead(ctx, d, m)
+}
+
+func resourceLookmlDashboardImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	dashboardID := d.Id()
+
+	log.Printf("[DEBUG] Delete imported dashboard %s", dashboardID)
+
+	_, err := client.DeleteDashboard(dashboardID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestAcc_LookmlDashboardImport(t *testing.T) {
+	title := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: lookmlDashboardImportConfig(title, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_import.test", "title", title),
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_import.test", "lookml_dashboard_id", "system__activity::user_activity"),
+				),
+			},
+			{
+				Config: lookmlDashboardImportConfig(title, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_import.test", "title", title),
+					resource.TestCheckResourceAttr("looker_lookml_dashboard_import.test", "sync_trigger", "2"),
+				),
+			},
+			{
+				ResourceName:            "looker_lookml_dashboard_import.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sync_trigger"},
+			},
+		},
+		CheckDestroy: testAccCheckLookmlDashboardImportDestroy,
+	})
+}
+
+func testAccCheckLookmlDashboardImportDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_lookml_dashboard_import" {
+			continue
+		}
+
+		dashboard, err := client.Dashboard(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		if dashboard.Deleted == nil || !*dashboard.Deleted {
+			return fmt.Errorf("imported dashboard still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func lookmlDashboardImportConfig(title, syncTrigger string) string {
+	return fmt.Sprintf(`
+	resource "looker_lookml_dashboard_import" "test" {
+		lookml_dashboard_id = "system__activity::user_activity"
+		folder_id           = "1"
+		title               = "%s"
+		sync_trigger        = "%s"
+	}
+	`, title, syncTrigger)
+}
@@ -0,0 +1,279 @@
+package looker
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceOauthClientApp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOauthClientAppCreate,
+		ReadContext:   resourceOauthClientAppRead,
+		UpdateContext: resourceOauthClientAppUpdate,
+		DeleteContext: resourceOauthClientAppDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_guid": {
+				Type:        schema.TypeString,
+				Description: "Globally unique ID of the application, used as the OAuth client ID.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"redirect_uri": {
+				Type:        schema.TypeString,
+				Description: "URI the application receives the authorization code at.",
+				Required:    true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the application shown to users when they activate it.",
+				Optional:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether requests from the application are accepted.",
+				Optional:    true,
+				Default:     true,
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Description: "Only allow members of this group to use the application.",
+				Optional:    true,
+			},
+			"tokens_version": {
+				Type: schema.TypeString,
+				Description: "Arbitrary value that invalidates every token issued to the application when " +
+					"changed. Looker OAuth client applications use PKCE instead of a client secret, so this " +
+					"is how their credentials are rotated.",
+				Optional: true,
+			},
+			"tokens_invalid_before": {
+				Type:        schema.TypeString,
+				Description: "Time before which all tokens issued to the application are invalid.",
+				Computed:    true,
+			},
+			"revoked_user_ids": {
+				Type: schema.TypeSet,
+				Description: "IDs of users whose activation of the application is revoked, invalidating their " +
+					"tokens. Users who activate the application again are revoked on the next apply.",
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"activated_users": {
+				Type:        schema.TypeList,
+				Description: "Users who have activated the application.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOauthClientAppCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	clientGUID := d.Get("client_guid").(string)
+	body := expandWriteOauthClientApp(d)
+
+	log.Printf("[DEBUG] Register OAuth client app %s", clientGUID)
+
+	oauthClientApp, err := client.RegisterOauthClientApp(clientGUID, body, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*oauthClientApp.ClientGuid)
+
+	if err = revokeOauthClientAppUsers(client, d.Id(), expandStringListFromSet(d.Get("revoked_user_ids"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceOauthClientAppRead(ctx, d, m)
+}
+
+func resourceOauthClientAppRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	oauthClientApp, err := client.OauthClientApp(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("client_guid", oauthClientApp.ClientGuid); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("redirect_uri", oauthClientApp.RedirectUri); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("display_name", oauthClientApp.DisplayName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", oauthClientApp.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enabled", oauthClientApp.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group_id", oauthClientApp.GroupId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tokensInvalidBefore := ""
+	if oauthClientApp.TokensInvalidBefore != nil {
+		tokensInvalidBefore = oauthClientApp.TokensInvalidBefore.Format(time.RFC3339)
+	}
+	if err = d.Set("tokens_invalid_before", tokensInvalidBefore); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var activatedUsers []apiclient.UserPublic
+	if oauthClientApp.ActivatedUsers != nil {
+		activatedUsers = *oauthClientApp.ActivatedUsers
+	}
+	if err = d.Set("activated_users", flattenOauthClientAppUsers(activatedUsers)); err != nil {
+		return diag.FromErr(err)
+	}
+	// revoked users who activated the application again show up as drift
+	if err = d.Set("revoked_user_ids", flattenRevokedUserIDs(expandStringListFromSet(d.Get("revoked_user_ids")), activatedUsers)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOauthClientAppUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	clientGUID := d.Id()
+	body := expandWriteOauthClientApp(d)
+
+	log.Printf("[DEBUG] Update OAuth client app %s", clientGUID)
+
+	_, err := client.UpdateOauthClientApp(clientGUID, body, "", nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("tokens_version") {
+		log.Printf("[DEBUG] Invalidate tokens of OAuth client app %s", clientGUID)
+
+		if _, err = client.InvalidateTokens(clientGUID, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("revoked_user_ids") {
+		o, n := d.GetChange("revoked_user_ids")
+		revoked := expandStringListFromSet(n.(*schema.Set).Difference(o.(*schema.Set)))
+		if err = revokeOauthClientAppUsers(client, clientGUID, revoked); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceOauthClientAppRead(ctx, d, m)
+}
+
+func resourceOauthClientAppDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	clientGUID := d.Id()
+
+	log.Printf("[DEBUG] Delete OAuth client app %s", clientGUID)
+
+	_, err := client.DeleteOauthClientApp(clientGUID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandWriteOauthClientApp(d *schema.ResourceData) apiclient.WriteOauthClientApp {
+	redirectURI := d.Get("redirect_uri").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	enabled := d.Get("enabled").(bool)
+
+	writeOauthClientApp := apiclient.WriteOauthClientApp{
+		RedirectUri: &redirectURI,
+		DisplayName: &displayName,
+		Description: &description,
+		Enabled:     &enabled,
+	}
+	// the group is only sent when configured, or cleared when it's removed
+	if configIsSet(d, "group_id") || d.HasChange("group_id") {
+		groupID := d.Get("group_id").(string)
+		writeOauthClientApp.GroupId = &groupID
+	}
+
+	return writeOauthClientApp
+}
+
+// revokeOauthClientAppUsers deactivates the application for the given users,
+// which is a no-op for users who haven't activated it.
+func revokeOauthClientAppUsers(client *apiclient.LookerSDK, clientGUID string, userIDs []string) error {
+	for _, userID := range userIDs {
+		log.Printf("[DEBUG] Revoke user %s of OAuth client app %s", userID, clientGUID)
+
+		if _, err := client.DeactivateAppUser(clientGUID, userID, "", nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flattenOauthClientAppUsers(users []apiclient.UserPublic) []interface{} {
+	vs := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		vs = append(vs, map[string]interface{}{
+			"id":           derefString(user.Id),
+			"display_name": derefString(user.DisplayName),
+		})
+	}
+	return vs
+}
+
+// flattenRevokedUserIDs returns the revoked users who haven't activated the
+// application again.
+func flattenRevokedUserIDs(revokedUserIDs []string, activatedUsers []apiclient.UserPublic) []string {
+	activated := make(map[string]bool, len(activatedUsers))
+	for _, user := range activatedUsers {
+		activated[derefString(user.Id)] = true
+	}
+
+	ids := make([]string, 0, len(revokedUserIDs))
+	for _, id := range revokedUserIDs {
+		if !activated[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
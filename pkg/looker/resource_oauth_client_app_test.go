@@ -0,0 +1,194 @@
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_OauthClientApp(t *testing.T) {
+	clientGUID := strings.ToLower(acctest.RandStringFromCharSet(16, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: oauthClientAppConfig(clientGUID, "Warehouse OAuth", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_oauth_client_app.test", "id", clientGUID),
+					resource.TestCheckResourceAttr("looker_oauth_client_app.test", "display_name", "Warehouse OAuth"),
+					resource.TestCheckResourceAttr("looker_oauth_client_app.test", "enabled", "true"),
+				),
+			},
+			{
+				Config: oauthClientAppConfig(clientGUID, "Warehouse OAuth (renamed)", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_oauth_client_app.test", "display_name", "Warehouse OAuth (renamed)"),
+					resource.TestCheckResourceAttrSet("looker_oauth_client_app.test", "tokens_invalid_before"),
+				),
+			},
+			{
+				ResourceName:            "looker_oauth_client_app.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tokens_version"},
+			},
+		},
+		CheckDestroy: testAccCheckOauthClientAppDestroy,
+	})
+}
+
+func testAccCheckOauthClientAppDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_oauth_client_app" {
+			continue
+		}
+
+		_, err := client.OauthClientApp(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("OAuth client app still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func oauthClientAppConfig(clientGUID, displayName, tokensVersion string) string {
+	return fmt.Sprintf(`
+	resource "looker_oauth_client_app" "test" {
+		client_guid    = "%s"
+		redirect_uri   = "https://app.example.com/oauth/callback"
+		display_name   = "%s"
+		description    = "Test application"
+		tokens_version = "%s"
+	}
+	`, clientGUID, displayName, tokensVersion)
+}
+
+type mockOauthClientAppAPI struct {
+	app               apiclient.OauthClientApp
+	written           []apiclient.WriteOauthClientApp
+	deactivated       []string
+	tokensInvalidated int
+}
+
+func (api *mockOauthClientAppAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/tokens"):
+		api.tokensInvalidated++
+	case r.Method == http.MethodDelete && strings.Contains(r.URL.Path, "/users/"):
+		userID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		api.deactivated = append(api.deactivated, userID)
+		if api.app.ActivatedUsers != nil {
+			var users []apiclient.UserPublic
+			for _, user := range *api.app.ActivatedUsers {
+				if derefString(user.Id) != userID {
+					users = append(users, user)
+				}
+			}
+			api.app.ActivatedUsers = &users
+		}
+	case r.Method == http.MethodPost || r.Method == http.MethodPatch:
+		var body apiclient.WriteOauthClientApp
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		api.written = append(api.written, body)
+		clientGUID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		api.app.ClientGuid = &clientGUID
+		api.app.RedirectUri = body.RedirectUri
+		api.app.DisplayName = body.DisplayName
+		api.app.Description = body.Description
+		api.app.Enabled = body.Enabled
+		if body.GroupId != nil {
+			api.app.GroupId = body.GroupId
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(api.app)
+}
+
+func TestOauthClientAppRevokedUsers(t *testing.T) {
+	api := &mockOauthClientAppAPI{}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"client_guid":      "warehouse_oauth",
+		"redirect_uri":     "https://app.example.com/oauth/callback",
+		"display_name":     "Warehouse OAuth",
+		"tokens_version":   "1",
+		"revoked_user_ids": []interface{}{"7"},
+	}
+
+	state, diags := applyTestResourceChange(t, resourceOauthClientApp(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"7"}, api.deactivated)
+	assert.Equal(t, 0, api.tokensInvalidated)
+	// the group isn't sent unless it's configured
+	assert.Nil(t, api.written[0].GroupId)
+
+	// a revoked user activating the application again is revoked on the next apply
+	id7, id8 := "7", "8"
+	api.app.ActivatedUsers = &[]apiclient.UserPublic{{Id: &id7}, {Id: &id8}}
+	api.deactivated = nil
+
+	state, diags = resourceOauthClientApp().RefreshWithoutUpgrade(context.Background(), state, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "0", state.Attributes["revoked_user_ids.#"])
+	assert.Equal(t, "2", state.Attributes["activated_users.#"])
+
+	state, diags = applyTestResourceChange(t, resourceOauthClientApp(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"7"}, api.deactivated)
+	assert.Equal(t, "1", state.Attributes["activated_users.#"])
+	assert.Equal(t, "8", state.Attributes["activated_users.0.id"])
+
+	// changing the tokens version invalidates all tokens
+	config["tokens_version"] = "2"
+
+	state, diags = applyTestResourceChange(t, resourceOauthClientApp(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, api.tokensInvalidated)
+
+	// a configured group is sent
+	config["group_id"] = "5"
+
+	state, diags = applyTestResourceChange(t, resourceOauthClientApp(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "5", derefString(api.written[len(api.written)-1].GroupId))
+	assert.Equal(t, "5", state.Attributes["group_id"])
+
+	// removing the group clears it
+	delete(config, "group_id")
+
+	state, diags = applyTestResourceChange(t, resourceOauthClientApp(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	if groupID := api.written[len(api.written)-1].GroupId; assert.NotNil(t, groupID) {
+		assert.Equal(t, "", *groupID)
+	}
+	assert.Equal(t, "", state.Attributes["group_id"])
+
+	// and the plan is then empty
+	diff, err := resourceOauthClientApp().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+}
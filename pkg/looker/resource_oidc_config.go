@@ -0,0 +1,499 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// oidcConfigID is the ID of the only OIDC configuration of a Looker instance.
+const oidcConfigID = "oidc_config"
+
+func resourceOidcConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOidcConfigCreate,
+		ReadContext:   resourceOidcConfigRead,
+		UpdateContext: resourceOidcConfigUpdate,
+		DeleteContext: resourceOidcConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceOidcConfigCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"authorization_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"token_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"userinfo_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"identifier": {
+				Type:        schema.TypeString,
+				Description: "Client ID of Looker, issued by the OpenID provider.",
+				Required:    true,
+			},
+			"secret": {
+				Type: schema.TypeString,
+				Description: "Client secret of Looker, issued by the OpenID provider. Changes are only sent to " +
+					"Looker when `secret_version` changes, since the Looker API doesn't return the secret.",
+				Required:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"secret_version": {
+				Type:        schema.TypeString,
+				Description: "Arbitrary value that sends `secret` to Looker again when changed, e.g. to rotate it.",
+				Optional:    true,
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Description: "Scopes to request from the OpenID provider.",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_attribute_map_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "email",
+			},
+			"user_attribute_map_first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "first_name",
+			},
+			"user_attribute_map_last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "last_name",
+			},
+			"new_user_migration_types": {
+				Type:        schema.TypeSet,
+				Description: "Types of credentials whose existing users are merged by email address on their first OIDC login.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"email", "ldap", "google", "saml"}, false),
+				},
+			},
+			"alternate_email_login_allowed": {
+				Type:        schema.TypeBool,
+				Description: "Allow admins and users with the `login_special_email` permission to log in with email and password.",
+				Optional:    true,
+			},
+			"default_new_user_role_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the roles of new users on their first OIDC login.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"default_new_user_group_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the groups of new users on their first OIDC login.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"set_roles_from_groups": {
+				Type:        schema.TypeBool,
+				Description: "Set the roles of users from their OIDC groups, as mapped by `group`.",
+				Optional:    true,
+			},
+			"groups_attribute": {
+				Type:        schema.TypeString,
+				Description: "Attribute listing the groups of users.",
+				Optional:    true,
+				Default:     "groups",
+			},
+			"group": {
+				Type:        schema.TypeSet,
+				Description: "Mappings of OIDC groups to Looker roles.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the group in OIDC.",
+							Required:    true,
+						},
+						"role_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"user_attribute": {
+				Type:        schema.TypeSet,
+				Description: "Mappings of OIDC attributes to Looker user attributes.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the attribute in OIDC.",
+							Required:    true,
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Description: "Whether users can only log in if the attribute is in the OIDC claims.",
+							Optional:    true,
+						},
+						"user_attribute_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"auth_requires_role": {
+				Type:        schema.TypeBool,
+				Description: "Only allow users to log in if OIDC grants them a role.",
+				Optional:    true,
+			},
+			"allow_direct_roles": {
+				Type:        schema.TypeBool,
+				Description: "Allow roles to be assigned directly to OIDC users.",
+				Optional:    true,
+			},
+			"allow_normal_group_membership": {
+				Type:        schema.TypeBool,
+				Description: "Allow OIDC users to be members of groups that aren't mapped from OIDC.",
+				Optional:    true,
+			},
+			"allow_roles_from_normal_groups": {
+				Type:        schema.TypeBool,
+				Description: "Let OIDC users inherit the roles of groups that aren't mapped from OIDC.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceOidcConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Update OIDC config")
+
+	// there's only one OIDC configuration, so creating it adopts it
+	_, err := client.UpdateOidcConfig(expandWriteOIDCConfig(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(oidcConfigID)
+
+	return resourceOidcConfigRead(ctx, d, m)
+}
+
+func resourceOidcConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	oidcConfig, err := client.OidcConfig(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("enabled", oidcConfig.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("issuer", oidcConfig.Issuer); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("audience", oidcConfig.Audience); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("authorization_endpoint", oidcConfig.AuthorizationEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("token_endpoint", oidcConfig.TokenEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("userinfo_endpoint", oidcConfig.UserinfoEndpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("identifier", oidcConfig.Identifier); err != nil {
+		return diag.FromErr(err)
+	}
+	if oidcConfig.Scopes != nil {
+		if err = d.Set("scopes", *oidcConfig.Scopes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("user_attribute_map_email", oidcConfig.UserAttributeMapEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_first_name", oidcConfig.UserAttributeMapFirstName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_last_name", oidcConfig.UserAttributeMapLastName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("new_user_migration_types", splitCommaSeparated(oidcConfig.NewUserMigrationTypes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("alternate_email_login_allowed", oidcConfig.AlternateEmailLoginAllowed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_new_user_role_ids", flattenDefaultNewUserRoleIDs(oidcConfig.DefaultNewUserRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_new_user_group_ids", flattenDefaultNewUserGroupIDs(oidcConfig.DefaultNewUserGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("set_roles_from_groups", oidcConfig.SetRolesFromGroups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_attribute", oidcConfig.GroupsAttribute); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group", flattenOIDCGroups(oidcConfig.GroupsWithRoleIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute", flattenOIDCUserAttributes(oidcConfig.UserAttributesWithIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_requires_role", oidcConfig.AuthRequiresRole); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_direct_roles", oidcConfig.AllowDirectRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_normal_group_membership", oidcConfig.AllowNormalGroupMembership); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_roles_from_normal_groups", oidcConfig.AllowRolesFromNormalGroups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceOidcConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Update OIDC config")
+
+	_, err := client.UpdateOidcConfig(expandWriteOIDCConfig(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceOidcConfigRead(ctx, d, m)
+}
+
+func resourceOidcConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	// the OIDC configuration can't be deleted, so it's disabled instead
+	log.Printf("[DEBUG] Disable OIDC")
+
+	enabled := false
+	_, err := client.UpdateOidcConfig(apiclient.WriteOIDCConfig{Enabled: &enabled}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceOidcConfigCustomizeDiff checks that the roles given to OIDC users
+// exist, since Looker only rejects unknown roles when they're applied.
+func resourceOidcConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// roles created in the same plan have unknown IDs
+	if !d.NewValueKnown("default_new_user_role_ids") || !d.NewValueKnown("group") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("default_new_user_role_ids", "group") {
+		return nil
+	}
+
+	roleIDs := expandStringListFromSet(d.Get("default_new_user_role_ids"))
+	for _, v := range d.Get("group").(*schema.Set).List() {
+		roleIDs = append(roleIDs, expandStringListFromSet(v.(map[string]interface{})["role_ids"])...)
+	}
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	client := m.(*apiclient.LookerSDK)
+
+	fields := "id"
+	roles, err := client.AllRoles(apiclient.RequestAllRoles{Fields: &fields}, nil)
+	if err != nil {
+		return err
+	}
+
+	return validateRoleIDsExist(roleIDs, roles)
+}
+
+// validateRoleIDsExist returns an error listing the role IDs that aren't the
+// ID of one of the given roles.
+func validateRoleIDsExist(roleIDs []string, roles []apiclient.Role) error {
+	existing := make(map[string]bool, len(roles))
+	for _, role := range roles {
+		existing[derefString(role.Id)] = true
+	}
+
+	var missing []string
+	seen := make(map[string]bool)
+	for _, id := range roleIDs {
+		if !existing[id] && !seen[id] {
+			missing = append(missing, id)
+			seen[id] = true
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("unknown role IDs: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func expandWriteOIDCConfig(d *schema.ResourceData) apiclient.WriteOIDCConfig {
+	enabled := d.Get("enabled").(bool)
+	issuer := d.Get("issuer").(string)
+	audience := d.Get("audience").(string)
+	authorizationEndpoint := d.Get("authorization_endpoint").(string)
+	tokenEndpoint := d.Get("token_endpoint").(string)
+	userinfoEndpoint := d.Get("userinfo_endpoint").(string)
+	identifier := d.Get("identifier").(string)
+	userAttributeMapEmail := d.Get("user_attribute_map_email").(string)
+	userAttributeMapFirstName := d.Get("user_attribute_map_first_name").(string)
+	userAttributeMapLastName := d.Get("user_attribute_map_last_name").(string)
+	newUserMigrationTypes := strings.Join(expandStringListFromSet(d.Get("new_user_migration_types")), ",")
+	alternateEmailLoginAllowed := d.Get("alternate_email_login_allowed").(bool)
+	defaultNewUserRoleIDs := expandStringListFromSet(d.Get("default_new_user_role_ids"))
+	defaultNewUserGroupIDs := expandStringListFromSet(d.Get("default_new_user_group_ids"))
+	setRolesFromGroups := d.Get("set_roles_from_groups").(bool)
+	groupsAttribute := d.Get("groups_attribute").(string)
+	authRequiresRole := d.Get("auth_requires_role").(bool)
+	allowDirectRoles := d.Get("allow_direct_roles").(bool)
+	allowNormalGroupMembership := d.Get("allow_normal_group_membership").(bool)
+	allowRolesFromNormalGroups := d.Get("allow_roles_from_normal_groups").(bool)
+
+	groups := make([]apiclient.OIDCGroupWrite, 0)
+	for _, v := range d.Get("group").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		roleIDs := expandStringListFromSet(raw["role_ids"])
+		groups = append(groups, apiclient.OIDCGroupWrite{
+			Name:    &name,
+			RoleIds: &roleIDs,
+		})
+	}
+
+	userAttributes := make([]apiclient.OIDCUserAttributeWrite, 0)
+	for _, v := range d.Get("user_attribute").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		required := raw["required"].(bool)
+		userAttributeIDs := expandStringListFromSet(raw["user_attribute_ids"])
+		userAttributes = append(userAttributes, apiclient.OIDCUserAttributeWrite{
+			Name:             &name,
+			Required:         &required,
+			UserAttributeIds: &userAttributeIDs,
+		})
+	}
+
+	writeOIDCConfig := apiclient.WriteOIDCConfig{
+		Enabled:                    &enabled,
+		Issuer:                     &issuer,
+		Audience:                   &audience,
+		AuthorizationEndpoint:      &authorizationEndpoint,
+		TokenEndpoint:              &tokenEndpoint,
+		UserinfoEndpoint:           &userinfoEndpoint,
+		Identifier:                 &identifier,
+		UserAttributeMapEmail:      &userAttributeMapEmail,
+		UserAttributeMapFirstName:  &userAttributeMapFirstName,
+		UserAttributeMapLastName:   &userAttributeMapLastName,
+		NewUserMigrationTypes:      &newUserMigrationTypes,
+		AlternateEmailLoginAllowed: &alternateEmailLoginAllowed,
+		DefaultNewUserRoleIds:      &defaultNewUserRoleIDs,
+		DefaultNewUserGroupIds:     &defaultNewUserGroupIDs,
+		SetRolesFromGroups:         &setRolesFromGroups,
+		GroupsAttribute:            &groupsAttribute,
+		GroupsWithRoleIds:          &groups,
+		UserAttributesWithIds:      &userAttributes,
+		AuthRequiresRole:           &authRequiresRole,
+		AllowDirectRoles:           &allowDirectRoles,
+		AllowNormalGroupMembership: &allowNormalGroupMembership,
+		AllowRolesFromNormalGroups: &allowRolesFromNormalGroups,
+	}
+
+	if scopes := expandStringList(d.Get("scopes")); len(scopes) > 0 {
+		writeOIDCConfig.Scopes = &scopes
+	}
+	// the secret is write-only and hidden from the diff, so read it from the
+	// configuration and only send it when it's new or rotated
+	if d.IsNewResource() || d.HasChange("secret_version") {
+		if secret, ok := configString(d, "secret"); ok {
+			writeOIDCConfig.Secret = &secret
+		}
+	}
+
+	return writeOIDCConfig
+}
+
+func flattenOIDCGroups(groups *[]apiclient.OIDCGroupWrite) []interface{} {
+	if groups == nil {
+		return []interface{}{}
+	}
+	vs := make([]interface{}, 0, len(*groups))
+	for _, group := range *groups {
+		var roleIDs []string
+		if group.RoleIds != nil {
+			roleIDs = *group.RoleIds
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":     derefString(group.Name),
+			"role_ids": flattenStringListToSet(roleIDs),
+		})
+	}
+	return vs
+}
+
+func flattenOIDCUserAttributes(userAttributes *[]apiclient.OIDCUserAttributeWrite) []interface{} {
+	if userAttributes == nil {
+		return []interface{}{}
+	}
+	vs := make([]interface{}, 0, len(*userAttributes))
+	for _, userAttribute := range *userAttributes {
+		var userAttributeIDs []string
+		if userAttribute.UserAttributeIds != nil {
+			userAttributeIDs = *userAttribute.UserAttributeIds
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":               derefString(userAttribute.Name),
+			"required":           derefBool(userAttribute.Required),
+			"user_attribute_ids": flattenStringListToSet(userAttributeIDs),
+		})
+	}
+	return vs
+}
@@ -0,0 +1,149 @@
+package looker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// unknownValue is how the SDK represents values that are only known after
+// apply in raw configurations, e.g. the IDs of roles created in the same plan.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// newMockOidcConfigAPI returns a mock of the OIDC configuration and of the
+// roles with the given IDs.
+func newMockOidcConfigAPI(roleIDs ...string) *mockSettingsAPI {
+	return &mockSettingsAPI{
+		config:    map[string]interface{}{"enabled": false},
+		roleIDs:   roleIDs,
+		writeOnly: []string{"secret"},
+		// the API only returns the roles of new users
+		idLists: map[string]string{"default_new_user_role_ids": "default_new_user_roles"},
+	}
+}
+
+func oidcTestConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":                   true,
+		"issuer":                    "https://idp.example.com",
+		"authorization_endpoint":    "https://idp.example.com/authorize",
+		"token_endpoint":            "https://idp.example.com/token",
+		"userinfo_endpoint":         "https://idp.example.com/userinfo",
+		"identifier":                "looker",
+		"secret":                    "old-secret",
+		"secret_version":            "1",
+		"scopes":                    []interface{}{"openid", "email", "profile"},
+		"new_user_migration_types":  []interface{}{"email"},
+		"default_new_user_role_ids": []interface{}{"2"},
+		"set_roles_from_groups":     true,
+		"group": []interface{}{
+			map[string]interface{}{
+				"name":     "Engineers",
+				"role_ids": []interface{}{"3"},
+			},
+		},
+	}
+}
+
+func TestOidcConfigLifecycle(t *testing.T) {
+	api := newMockOidcConfigAPI("2", "3")
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := oidcTestConfig()
+
+	state, diags := applyTestResourceChange(t, resourceOidcConfig(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, oidcConfigID, state.ID)
+	assert.Equal(t, "old-secret", api.updates[0]["secret"])
+	assert.Equal(t, "email", api.config["new_user_migration_types"])
+	assert.Equal(t, "1", state.Attributes["default_new_user_role_ids.#"])
+	assert.Equal(t, "1", state.Attributes["group.#"])
+
+	// reading the configuration back doesn't show any changes
+	diff, err := resourceOidcConfig().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// changing the secret alone isn't detected
+	config["secret"] = "new-secret"
+
+	diff, err = resourceOidcConfig().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// bumping the version sends the new secret
+	config["secret_version"] = "2"
+
+	state, diags = applyTestResourceChange(t, resourceOidcConfig(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "new-secret", api.updates[1]["secret"])
+
+	// other changes don't send the secret again
+	config["issuer"] = "https://login.example.com"
+
+	state, diags = applyTestResourceChange(t, resourceOidcConfig(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.NotContains(t, api.updates[2], "secret")
+	assert.Equal(t, "https://login.example.com", state.Attributes["issuer"])
+
+	// destroying the resource disables OIDC
+	diags = resourceOidcConfig().DeleteContext(context.Background(), resourceOidcConfig().Data(state), client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, false, api.config["enabled"])
+}
+
+func TestOidcConfigCustomizeDiff(t *testing.T) {
+	api := newMockOidcConfigAPI("2", "3")
+	client := newTestClient(t, api.ServeHTTP)
+
+	// roles that don't exist are rejected when planning
+	config := oidcTestConfig()
+	config["group"] = []interface{}{
+		map[string]interface{}{"name": "Engineers", "role_ids": []interface{}{"3", "404"}},
+		map[string]interface{}{"name": "Analysts", "role_ids": []interface{}{"404"}},
+	}
+
+	_, err := resourceOidcConfig().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unknown role IDs: 404")
+	}
+
+	// roles created in the same plan aren't checked
+	api.calls = nil
+	config["default_new_user_role_ids"] = []interface{}{unknownValue}
+	config["group"] = []interface{}{
+		map[string]interface{}{"name": "Engineers", "role_ids": []interface{}{unknownValue}},
+	}
+
+	_, err = resourceOidcConfig().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.Empty(t, api.calls)
+}
+
+func TestValidateRoleIDsExist(t *testing.T) {
+	id := func(s string) apiclient.Role { return apiclient.Role{Id: &s} }
+	roles := []apiclient.Role{id("1"), id("2")}
+
+	cases := map[string]struct {
+		roleIDs []string
+		err     string
+	}{
+		"existing": {roleIDs: []string{"1", "2", "1"}},
+		"none":     {roleIDs: nil},
+		"missing":  {roleIDs: []string{"1", "3", "4", "3"}, err: "unknown role IDs: 3, 4"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateRoleIDsExist(c.roleIDs, roles)
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.err)
+			}
+		})
+	}
+}
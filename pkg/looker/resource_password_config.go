@@ -0,0 +1,158 @@
+package looker
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// passwordConfigID is the ID of the only password configuration of a Looker
+// instance.
+const passwordConfigID = "password_config"
+
+// Creating the password configuration adopts the current one, so attributes
+// that aren't configured keep their value, and destroying it resets it to the
+// defaults of Looker.
+func resourcePasswordConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePasswordConfigCreate,
+		ReadContext:   resourcePasswordConfigRead,
+		UpdateContext: resourcePasswordConfigUpdate,
+		DeleteContext: resourcePasswordConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"min_length": {
+				Type:         schema.TypeInt,
+				Description:  "Minimum number of characters of passwords. Looker defaults to 7.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 100),
+			},
+			"require_numeric": {
+				Type:        schema.TypeBool,
+				Description: "Require passwords to have at least one numeric character.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"require_upperlower": {
+				Type:        schema.TypeBool,
+				Description: "Require passwords to have at least one uppercase and one lowercase letter.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"require_special": {
+				Type:        schema.TypeBool,
+				Description: "Require passwords to have at least one special character.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourcePasswordConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Update password config")
+
+	_, err := client.UpdatePasswordConfig(expandWritePasswordConfig(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(passwordConfigID)
+
+	return resourcePasswordConfigRead(ctx, d, m)
+}
+
+func resourcePasswordConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	passwordConfig, err := client.PasswordConfig(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("min_length", passwordConfig.MinLength); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_numeric", passwordConfig.RequireNumeric); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_upperlower", passwordConfig.RequireUpperlower); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_special", passwordConfig.RequireSpecial); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourcePasswordConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Update password config")
+
+	_, err := client.UpdatePasswordConfig(expandWritePasswordConfig(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePasswordConfigRead(ctx, d, m)
+}
+
+func resourcePasswordConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Reset password config")
+
+	minLength := int64(7)
+	requireNumeric := false
+	requireUpperlower := false
+	requireSpecial := false
+
+	_, err := client.UpdatePasswordConfig(apiclient.WritePasswordConfig{
+		MinLength:         &minLength,
+		RequireNumeric:    &requireNumeric,
+		RequireUpperlower: &requireUpperlower,
+		RequireSpecial:    &requireSpecial,
+	}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// expandWritePasswordConfig only returns the configured attributes, so that
+// the others keep their current value.
+func expandWritePasswordConfig(d *schema.ResourceData) apiclient.WritePasswordConfig {
+	var writePasswordConfig apiclient.WritePasswordConfig
+
+	if configIsSet(d, "min_length") {
+		minLength := int64(d.Get("min_length").(int))
+		writePasswordConfig.MinLength = &minLength
+	}
+	if configIsSet(d, "require_numeric") {
+		requireNumeric := d.Get("require_numeric").(bool)
+		writePasswordConfig.RequireNumeric = &requireNumeric
+	}
+	if configIsSet(d, "require_upperlower") {
+		requireUpperlower := d.Get("require_upperlower").(bool)
+		writePasswordConfig.RequireUpperlower = &requireUpperlower
+	}
+	if configIsSet(d, "require_special") {
+		requireSpecial := d.Get("require_special").(bool)
+		writePasswordConfig.RequireSpecial = &requireSpecial
+	}
+
+	return writePasswordConfig
+}
@@ -0,0 +1,60 @@
+package looker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordConfigLifecycle(t *testing.T) {
+	api := &mockSettingsAPI{config: map[string]interface{}{
+		"min_length":         12,
+		"require_numeric":    true,
+		"require_upperlower": true,
+		"require_special":    false,
+	}}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"min_length":      16,
+		"require_special": false,
+	}
+
+	// creating the resource adopts the attributes that aren't configured
+	state, diags := applyTestResourceChange(t, resourcePasswordConfig(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, passwordConfigID, state.ID)
+	assert.Equal(t, map[string]interface{}{"min_length": float64(16), "require_special": false}, api.updates[0])
+	assert.Equal(t, "16", state.Attributes["min_length"])
+	assert.Equal(t, "true", state.Attributes["require_numeric"])
+	assert.Equal(t, "true", state.Attributes["require_upperlower"])
+
+	// reading the configuration back doesn't show any changes
+	diff, err := resourcePasswordConfig().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// updates send every configured attribute, reverting changes made outside of Terraform
+	api.config["require_special"] = true
+	config["require_numeric"] = false
+
+	state, diags = applyTestResourceChange(t, resourcePasswordConfig(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, map[string]interface{}{
+		"min_length":      float64(16),
+		"require_numeric": false,
+		"require_special": false,
+	}, api.updates[1])
+
+	// destroying the resource resets the defaults of Looker
+	diags = resourcePasswordConfig().DeleteContext(context.Background(), resourcePasswordConfig().Data(state), client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, map[string]interface{}{
+		"min_length":         float64(7),
+		"require_numeric":    false,
+		"require_upperlower": false,
+		"require_special":    false,
+	}, api.config)
+}
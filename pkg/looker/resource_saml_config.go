@@ -0,0 +1,484 @@
+package looker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// samlConfigID is the ID of the only SAML configuration of a Looker instance.
+const samlConfigID = "saml_config"
+
+func resourceSamlConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSamlConfigCreate,
+		ReadContext:   resourceSamlConfigRead,
+		UpdateContext: resourceSamlConfigUpdate,
+		DeleteContext: resourceSamlConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"idp_url": {
+				Type:        schema.TypeString,
+				Description: "Single sign-on URL of the identity provider.",
+				Required:    true,
+			},
+			"idp_issuer": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idp_cert": {
+				Type:        schema.TypeString,
+				Description: "X.509 certificate of the identity provider, in PEM format.",
+				Required:    true,
+			},
+			"idp_audience": {
+				Type:        schema.TypeString,
+				Description: "Audience Looker checks assertions are issued for. Not checked if empty.",
+				Optional:    true,
+			},
+			"allowed_clock_drift": {
+				Type:        schema.TypeInt,
+				Description: "Seconds of clock drift to allow when validating the timestamps of assertions.",
+				Optional:    true,
+				Default:     10,
+			},
+			"user_attribute_map_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Email",
+			},
+			"user_attribute_map_first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "FName",
+			},
+			"user_attribute_map_last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "LName",
+			},
+			"new_user_migration_types": {
+				Type:        schema.TypeSet,
+				Description: "Types of credentials whose existing users are merged by email address on their first SAML login.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"email", "ldap", "google"}, false),
+				},
+			},
+			"alternate_email_login_allowed": {
+				Type:        schema.TypeBool,
+				Description: "Allow admins and users with the `login_special_email` permission to log in with email and password.",
+				Optional:    true,
+			},
+			"default_new_user_role_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the roles of new users on their first SAML login.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"default_new_user_group_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the groups of new users on their first SAML login.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"set_roles_from_groups": {
+				Type:        schema.TypeBool,
+				Description: "Set the roles of users from their SAML groups, as mapped by `group`.",
+				Optional:    true,
+			},
+			"groups_finder_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "grouped_attribute_values",
+				ValidateFunc: validation.StringInSlice([]string{
+					"grouped_attribute_values",
+					"individual_attributes",
+				}, false),
+			},
+			"groups_attribute": {
+				Type:        schema.TypeString,
+				Description: "Attribute listing the groups of users, when `groups_finder_type` is `grouped_attribute_values`.",
+				Optional:    true,
+			},
+			"groups_member_value": {
+				Type:        schema.TypeString,
+				Description: "Value of group attributes for members, when `groups_finder_type` is `individual_attributes`.",
+				Optional:    true,
+			},
+			"group": {
+				Type:        schema.TypeSet,
+				Description: "Mappings of SAML groups to Looker roles.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the group in SAML.",
+							Required:    true,
+						},
+						"role_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"user_attribute": {
+				Type:        schema.TypeSet,
+				Description: "Mappings of SAML attributes to Looker user attributes.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the attribute in SAML.",
+							Required:    true,
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Description: "Whether users can only log in if the attribute is in the SAML assertion.",
+							Optional:    true,
+						},
+						"user_attribute_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"auth_requires_role": {
+				Type:        schema.TypeBool,
+				Description: "Only allow users to log in if SAML grants them a role.",
+				Optional:    true,
+			},
+			"allow_direct_roles": {
+				Type:        schema.TypeBool,
+				Description: "Allow roles to be assigned directly to SAML users.",
+				Optional:    true,
+			},
+			"allow_normal_group_membership": {
+				Type:        schema.TypeBool,
+				Description: "Allow SAML users to be members of groups that aren't mapped from SAML.",
+				Optional:    true,
+			},
+			"allow_roles_from_normal_groups": {
+				Type:        schema.TypeBool,
+				Description: "Let SAML users inherit the roles of groups that aren't mapped from SAML.",
+				Optional:    true,
+			},
+			"bypass_login_page": {
+				Type:        schema.TypeBool,
+				Description: "Redirect users to the identity provider instead of showing the login page.",
+				Optional:    true,
+			},
+			"test_before_enable": {
+				Type: schema.TypeBool,
+				Description: "Create a SAML test configuration with the same settings, which Looker validates, " +
+					"before enabling SAML or changing it while enabled.",
+				Optional: true,
+				Default:  true,
+			},
+			"modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSamlConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	// there's only one SAML configuration, so creating it adopts it
+	if err := updateSamlConfig(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(samlConfigID)
+
+	return resourceSamlConfigRead(ctx, d, m)
+}
+
+func resourceSamlConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	samlConfig, err := client.SamlConfig(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("enabled", samlConfig.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_url", samlConfig.IdpUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_issuer", samlConfig.IdpIssuer); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_cert", samlConfig.IdpCert); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("idp_audience", samlConfig.IdpAudience); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allowed_clock_drift", samlConfig.AllowedClockDrift); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_email", samlConfig.UserAttributeMapEmail); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_first_name", samlConfig.UserAttributeMapFirstName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute_map_last_name", samlConfig.UserAttributeMapLastName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("new_user_migration_types", splitCommaSeparated(samlConfig.NewUserMigrationTypes)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("alternate_email_login_allowed", samlConfig.AlternateEmailLoginAllowed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_new_user_role_ids", flattenDefaultNewUserRoleIDs(samlConfig.DefaultNewUserRoles)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_new_user_group_ids", flattenDefaultNewUserGroupIDs(samlConfig.DefaultNewUserGroups)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("set_roles_from_groups", samlConfig.SetRolesFromGroups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_finder_type", samlConfig.GroupsFinderType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_attribute", samlConfig.GroupsAttribute); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("groups_member_value", samlConfig.GroupsMemberValue); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("group", flattenSamlGroups(samlConfig.GroupsWithRoleIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_attribute", flattenSamlUserAttributes(samlConfig.UserAttributesWithIds)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("auth_requires_role", samlConfig.AuthRequiresRole); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_direct_roles", samlConfig.AllowDirectRoles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_normal_group_membership", samlConfig.AllowNormalGroupMembership); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_roles_from_normal_groups", samlConfig.AllowRolesFromNormalGroups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("bypass_login_page", samlConfig.BypassLoginPage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("modified_at", samlConfig.ModifiedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSamlConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	if err := updateSamlConfig(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSamlConfigRead(ctx, d, m)
+}
+
+func resourceSamlConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	// the SAML configuration can't be deleted, so it's disabled instead
+	log.Printf("[DEBUG] Disable SAML")
+
+	enabled := false
+	_, err := client.UpdateSamlConfig(apiclient.WriteSamlConfig{Enabled: &enabled}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// updateSamlConfig saves the SAML configuration, after checking that Looker
+// accepts it as a test configuration if it's going to be enabled.
+func updateSamlConfig(client *apiclient.LookerSDK, d *schema.ResourceData) error {
+	body := expandWriteSamlConfig(d)
+
+	if *body.Enabled && d.Get("test_before_enable").(bool) {
+		log.Printf("[DEBUG] Create SAML test config")
+
+		testConfig, err := client.CreateSamlTestConfig(body, nil)
+		if err != nil {
+			return fmt.Errorf("SAML test config was rejected, so SAML was not changed: %w", err)
+		}
+		if testSlug := derefString(testConfig.TestSlug); testSlug != "" {
+			if _, err = client.DeleteSamlTestConfig(testSlug, nil); err != nil {
+				log.Printf("[WARN] Failed to delete SAML test config %s: %v", testSlug, err)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Update SAML config")
+
+	_, err := client.UpdateSamlConfig(body, nil)
+	return err
+}
+
+func expandWriteSamlConfig(d *schema.ResourceData) apiclient.WriteSamlConfig {
+	enabled := d.Get("enabled").(bool)
+	idpURL := d.Get("idp_url").(string)
+	idpIssuer := d.Get("idp_issuer").(string)
+	idpCert := d.Get("idp_cert").(string)
+	idpAudience := d.Get("idp_audience").(string)
+	allowedClockDrift := int64(d.Get("allowed_clock_drift").(int))
+	userAttributeMapEmail := d.Get("user_attribute_map_email").(string)
+	userAttributeMapFirstName := d.Get("user_attribute_map_first_name").(string)
+	userAttributeMapLastName := d.Get("user_attribute_map_last_name").(string)
+	newUserMigrationTypes := strings.Join(expandStringListFromSet(d.Get("new_user_migration_types")), ",")
+	alternateEmailLoginAllowed := d.Get("alternate_email_login_allowed").(bool)
+	defaultNewUserRoleIDs := expandStringListFromSet(d.Get("default_new_user_role_ids"))
+	defaultNewUserGroupIDs := expandStringListFromSet(d.Get("default_new_user_group_ids"))
+	setRolesFromGroups := d.Get("set_roles_from_groups").(bool)
+	groupsFinderType := d.Get("groups_finder_type").(string)
+	groupsAttribute := d.Get("groups_attribute").(string)
+	groupsMemberValue := d.Get("groups_member_value").(string)
+	authRequiresRole := d.Get("auth_requires_role").(bool)
+	allowDirectRoles := d.Get("allow_direct_roles").(bool)
+	allowNormalGroupMembership := d.Get("allow_normal_group_membership").(bool)
+	allowRolesFromNormalGroups := d.Get("allow_roles_from_normal_groups").(bool)
+	bypassLoginPage := d.Get("bypass_login_page").(bool)
+
+	groups := make([]apiclient.SamlGroupWrite, 0)
+	for _, v := range d.Get("group").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		roleIDs := expandStringListFromSet(raw["role_ids"])
+		groups = append(groups, apiclient.SamlGroupWrite{
+			Name:    &name,
+			RoleIds: &roleIDs,
+		})
+	}
+
+	userAttributes := make([]apiclient.SamlUserAttributeWrite, 0)
+	for _, v := range d.Get("user_attribute").(*schema.Set).List() {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		required := raw["required"].(bool)
+		userAttributeIDs := expandStringListFromSet(raw["user_attribute_ids"])
+		userAttributes = append(userAttributes, apiclient.SamlUserAttributeWrite{
+			Name:             &name,
+			Required:         &required,
+			UserAttributeIds: &userAttributeIDs,
+		})
+	}
+
+	return apiclient.WriteSamlConfig{
+		Enabled:                    &enabled,
+		IdpUrl:                     &idpURL,
+		IdpIssuer:                  &idpIssuer,
+		IdpCert:                    &idpCert,
+		IdpAudience:                &idpAudience,
+		AllowedClockDrift:          &allowedClockDrift,
+		UserAttributeMapEmail:      &userAttributeMapEmail,
+		UserAttributeMapFirstName:  &userAttributeMapFirstName,
+		UserAttributeMapLastName:   &userAttributeMapLastName,
+		NewUserMigrationTypes:      &newUserMigrationTypes,
+		AlternateEmailLoginAllowed: &alternateEmailLoginAllowed,
+		DefaultNewUserRoleIds:      &defaultNewUserRoleIDs,
+		DefaultNewUserGroupIds:     &defaultNewUserGroupIDs,
+		SetRolesFromGroups:         &setRolesFromGroups,
+		GroupsFinderType:           &groupsFinderType,
+		GroupsAttribute:            &groupsAttribute,
+		GroupsMemberValue:          &groupsMemberValue,
+		GroupsWithRoleIds:          &groups,
+		AuthRequiresRole:           &authRequiresRole,
+		UserAttributesWithIds:      &userAttributes,
+		AllowDirectRoles:           &allowDirectRoles,
+		AllowNormalGroupMembership: &allowNormalGroupMembership,
+		AllowRolesFromNormalGroups: &allowRolesFromNormalGroups,
+		BypassLoginPage:            &bypassLoginPage,
+	}
+}
+
+func flattenSamlGroups(groups *[]apiclient.SamlGroupWrite) []interface{} {
+	if groups == nil {
+		return []interface{}{}
+	}
+	vs := make([]interface{}, 0, len(*groups))
+	for _, group := range *groups {
+		var roleIDs []string
+		if group.RoleIds != nil {
+			roleIDs = *group.RoleIds
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":     derefString(group.Name),
+			"role_ids": flattenStringListToSet(roleIDs),
+		})
+	}
+	return vs
+}
+
+func flattenSamlUserAttributes(userAttributes *[]apiclient.SamlUserAttributeWrite) []interface{} {
+	if userAttributes == nil {
+		return []interface{}{}
+	}
+	vs := make([]interface{}, 0, len(*userAttributes))
+	for _, userAttribute := range *userAttributes {
+		var userAttributeIDs []string
+		if userAttribute.UserAttributeIds != nil {
+			userAttributeIDs = *userAttribute.UserAttributeIds
+		}
+		vs = append(vs, map[string]interface{}{
+			"name":               derefString(userAttribute.Name),
+			"required":           derefBool(userAttribute.Required),
+			"user_attribute_ids": flattenStringListToSet(userAttributeIDs),
+		})
+	}
+	return vs
+}
+
+func flattenDefaultNewUserRoleIDs(roles *[]apiclient.Role) []string {
+	roleIDs := make([]string, 0)
+	if roles != nil {
+		for _, role := range *roles {
+			roleIDs = append(roleIDs, derefString(role.Id))
+		}
+	}
+	return roleIDs
+}
+
+func flattenDefaultNewUserGroupIDs(groups *[]apiclient.Group) []string {
+	if groups == nil {
+		return []string{}
+	}
+	return flattenGroupIDs(*groups)
+}
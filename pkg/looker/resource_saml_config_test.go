@@ -0,0 +1,134 @@
+package looker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockSamlConfigAPI returns a mock of the SAML configuration that
+// accepts test configurations.
+func newMockSamlConfigAPI() *mockSettingsAPI {
+	return &mockSettingsAPI{
+		config: map[string]interface{}{"enabled": false},
+		// the API only returns the roles and groups of new users
+		idLists: map[string]string{
+			"default_new_user_role_ids":  "default_new_user_roles",
+			"default_new_user_group_ids": "default_new_user_groups",
+		},
+		tests: map[string]interface{}{
+			"POST /api/4.0/saml_test_configs":        map[string]interface{}{"test_slug": "slug"},
+			"DELETE /api/4.0/saml_test_configs/slug": "",
+		},
+	}
+}
+
+func samlTestConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":                   true,
+		"idp_url":                   "https://idp.example.com/sso",
+		"idp_issuer":                "https://idp.example.com",
+		"idp_cert":                  "MIICertificate",
+		"new_user_migration_types":  []interface{}{"email"},
+		"default_new_user_role_ids": []interface{}{"2"},
+		"set_roles_from_groups":     true,
+		"groups_attribute":          "Groups",
+		"group": []interface{}{
+			map[string]interface{}{
+				"name":     "Engineers",
+				"role_ids": []interface{}{"3"},
+			},
+		},
+		"user_attribute": []interface{}{
+			map[string]interface{}{
+				"name":               "Department",
+				"required":           true,
+				"user_attribute_ids": []interface{}{"5"},
+			},
+		},
+		"auth_requires_role": true,
+	}
+}
+
+func TestSamlConfigLifecycle(t *testing.T) {
+	api := newMockSamlConfigAPI()
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := samlTestConfig()
+
+	state, diags := applyTestResourceChange(t, resourceSamlConfig(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, samlConfigID, state.ID)
+	// the settings are tested before SAML is enabled
+	assert.Equal(t, []string{
+		"POST /api/4.0/saml_test_configs",
+		"DELETE /api/4.0/saml_test_configs/slug",
+		"PATCH /api/4.0/saml_config",
+		"GET /api/4.0/saml_config",
+	}, api.calls)
+	assert.Equal(t, "email", api.config["new_user_migration_types"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "Engineers", "role_ids": []interface{}{"3"}},
+	}, api.config["groups_with_role_ids"])
+	assert.Equal(t, "1", state.Attributes["default_new_user_role_ids.#"])
+	assert.Equal(t, "1", state.Attributes["group.#"])
+	assert.Equal(t, "1", state.Attributes["user_attribute.#"])
+
+	// reading the configuration back doesn't show any changes
+	diff, err := resourceSamlConfig().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// disabling SAML doesn't test the settings
+	api.calls = nil
+	config["enabled"] = false
+
+	state, diags = applyTestResourceChange(t, resourceSamlConfig(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{
+		"PATCH /api/4.0/saml_config",
+		"GET /api/4.0/saml_config",
+	}, api.calls)
+
+	// destroying the resource disables SAML
+	api.config["enabled"] = true
+	api.calls = nil
+
+	diags = resourceSamlConfig().DeleteContext(context.Background(), resourceSamlConfig().Data(state), client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"PATCH /api/4.0/saml_config"}, api.calls)
+	assert.Equal(t, false, api.config["enabled"])
+}
+
+func TestSamlConfigRejectedTestConfig(t *testing.T) {
+	api := newMockSamlConfigAPI()
+	api.tests["POST /api/4.0/saml_test_configs"] = errors.New("Invalid IdP certificate")
+	client := newTestClient(t, api.ServeHTTP)
+
+	_, diags := applyTestResourceChange(t, resourceSamlConfig(), nil, samlTestConfig(), client)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Summary, "SAML test config was rejected, so SAML was not changed")
+	}
+	// SAML isn't enabled with settings Looker rejected
+	assert.Equal(t, []string{"POST /api/4.0/saml_test_configs"}, api.calls)
+	assert.Equal(t, false, api.config["enabled"])
+}
+
+func TestFlattenSamlGroups(t *testing.T) {
+	name := "Engineers"
+	roleIDs := []string{"3"}
+
+	groups := flattenSamlGroups(&[]apiclient.SamlGroupWrite{{Name: &name, RoleIds: &roleIDs}})
+
+	if assert.Len(t, groups, 1) {
+		group := groups[0].(map[string]interface{})
+		assert.Equal(t, "Engineers", group["name"])
+		assert.Equal(t, []interface{}{"3"}, group["role_ids"].(*schema.Set).List())
+	}
+	assert.Empty(t, flattenSamlGroups(nil))
+}
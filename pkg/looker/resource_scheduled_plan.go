@@ -0,0 +1,372 @@
+package looker
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceScheduledPlan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScheduledPlanCreate,
+		ReadContext:   resourceScheduledPlanRead,
+		UpdateContext: resourceScheduledPlanUpdate,
+		DeleteContext: resourceScheduledPlanDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the user owning the scheduled plan. Defaults to the API user.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"dashboard_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"dashboard_id", "look_id", "lookml_dashboard_id"},
+			},
+			"look_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"dashboard_id", "look_id", "lookml_dashboard_id"},
+			},
+			"lookml_dashboard_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"dashboard_id", "look_id", "lookml_dashboard_id"},
+			},
+			"crontab": {
+				Type:         schema.TypeString,
+				Description:  "Vixie-style crontab specifying when to run.",
+				Optional:     true,
+				ExactlyOneOf: []string{"crontab", "datagroup"},
+			},
+			"datagroup": {
+				Type:         schema.TypeString,
+				Description:  "Name of a datagroup whose trigger runs the plan.",
+				Optional:     true,
+				ExactlyOneOf: []string{"crontab", "datagroup"},
+			},
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"run_as_recipient": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"filters_string": {
+				Type:        schema.TypeString,
+				Description: "Query string of filters to run the look or dashboard with, e.g. `?Created+Date=7+days`.",
+				Optional:    true,
+			},
+			"require_results": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"require_no_results": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"require_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"include_links": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"email", "webhook", "s3", "sftp"}, false),
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Description: "Email address, webhook URL, S3 bucket (`s3://bucket/path`) or SFTP URL (`sftp://host/path`).",
+							Required:    true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"apply_formatting": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"apply_vis": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"parameters": {
+							Type:             schema.TypeString,
+							Description:      "JSON object with destination parameters, e.g. `region` and `access_key_id` for S3 or `username` for SFTP.",
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+						"secret_parameters": {
+							Type: schema.TypeString,
+							Description: "JSON object with secret destination parameters, e.g. `secret_access_key` for S3 " +
+								"or `password` for SFTP. Due to limitations in the Looker API, changes made outside " +
+								"of Terraform cannot be detected.",
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsJSON,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScheduledPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	body := expandWriteScheduledPlan(d)
+
+	log.Printf("[DEBUG] Create scheduled plan %s", *body.Name)
+
+	scheduledPlan, err := client.CreateScheduledPlan(body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*scheduledPlan.Id)
+
+	return resourceScheduledPlanRead(ctx, d, m)
+}
+
+func resourceScheduledPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	scheduledPlan, err := client.ScheduledPlan(d.Id(), "", nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("name", scheduledPlan.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("user_id", scheduledPlan.UserId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enabled", scheduledPlan.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("dashboard_id", scheduledPlan.DashboardId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("look_id", scheduledPlan.LookId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("lookml_dashboard_id", scheduledPlan.LookmlDashboardId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("crontab", scheduledPlan.Crontab); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("datagroup", scheduledPlan.Datagroup); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("timezone", scheduledPlan.Timezone); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("run_as_recipient", scheduledPlan.RunAsRecipient); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("filters_string", scheduledPlan.FiltersString); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_results", scheduledPlan.RequireResults); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_no_results", scheduledPlan.RequireNoResults); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("require_change", scheduledPlan.RequireChange); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("include_links", scheduledPlan.IncludeLinks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var destinations []apiclient.ScheduledPlanDestination
+	if scheduledPlan.ScheduledPlanDestination != nil {
+		destinations = *scheduledPlan.ScheduledPlanDestination
+	}
+	if err = d.Set("destination", flattenScheduledPlanDestinations(destinations, d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScheduledPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	scheduledPlanID := d.Id()
+	body := expandWriteScheduledPlan(d)
+
+	log.Printf("[DEBUG] Update scheduled plan %s", scheduledPlanID)
+
+	_, err := client.UpdateScheduledPlan(scheduledPlanID, body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScheduledPlanRead(ctx, d, m)
+}
+
+func resourceScheduledPlanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	scheduledPlanID := d.Id()
+
+	log.Printf("[DEBUG] Delete scheduled plan %s", scheduledPlanID)
+
+	_, err := client.DeleteScheduledPlan(scheduledPlanID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandWriteScheduledPlan(d *schema.ResourceData) apiclient.WriteScheduledPlan {
+	name := d.Get("name").(string)
+	enabled := d.Get("enabled").(bool)
+	runAsRecipient := d.Get("run_as_recipient").(bool)
+	filtersString := d.Get("filters_string").(string)
+	requireResults := d.Get("require_results").(bool)
+	requireNoResults := d.Get("require_no_results").(bool)
+	requireChange := d.Get("require_change").(bool)
+	includeLinks := d.Get("include_links").(bool)
+
+	body := apiclient.WriteScheduledPlan{
+		Name:             &name,
+		Enabled:          &enabled,
+		RunAsRecipient:   &runAsRecipient,
+		FiltersString:    &filtersString,
+		RequireResults:   &requireResults,
+		RequireNoResults: &requireNoResults,
+		RequireChange:    &requireChange,
+		IncludeLinks:     &includeLinks,
+	}
+
+	if v, ok := d.GetOk("user_id"); ok {
+		userID := v.(string)
+		body.UserId = &userID
+	}
+	// the content and the trigger are each one of several alternatives, so the
+	// ones that are no longer configured are cleared when switching
+	alternative := func(key string) *string {
+		if v, ok := d.GetOk(key); ok {
+			value := v.(string)
+			return &value
+		}
+		if d.HasChange(key) {
+			value := ""
+			return &value
+		}
+		return nil
+	}
+	body.DashboardId = alternative("dashboard_id")
+	body.LookId = alternative("look_id")
+	body.LookmlDashboardId = alternative("lookml_dashboard_id")
+	body.Crontab = alternative("crontab")
+	body.Datagroup = alternative("datagroup")
+	if v, ok := d.GetOk("timezone"); ok {
+		timezone := v.(string)
+		body.Timezone = &timezone
+	}
+
+	var destinations []apiclient.ScheduledPlanDestination
+	for _, v := range d.Get("destination").([]interface{}) {
+		raw := v.(map[string]interface{})
+		destinationType := raw["type"].(string)
+		address := raw["address"].(string)
+		format := raw["format"].(string)
+		applyFormatting := raw["apply_formatting"].(bool)
+		applyVis := raw["apply_vis"].(bool)
+		destination := apiclient.ScheduledPlanDestination{
+			Type:            &destinationType,
+			Address:         &address,
+			Format:          &format,
+			ApplyFormatting: &applyFormatting,
+			ApplyVis:        &applyVis,
+		}
+		if message := raw["message"].(string); message != "" {
+			destination.Message = &message
+		}
+		if parameters := raw["parameters"].(string); parameters != "" {
+			destination.Parameters = &parameters
+		}
+		if secretParameters := raw["secret_parameters"].(string); secretParameters != "" {
+			destination.SecretParameters = &secretParameters
+		}
+		destinations = append(destinations, destination)
+	}
+	body.ScheduledPlanDestination = &destinations
+
+	return body
+}
+
+// flattenScheduledPlanDestinations converts the destinations returned by the
+// API. secret_parameters is write-only, so it's carried over from the state of
+// the destination at the same position.
+func flattenScheduledPlanDestinations(destinations []apiclient.ScheduledPlanDestination, d *schema.ResourceData) []interface{} {
+	current := d.Get("destination").([]interface{})
+
+	vs := make([]interface{}, 0, len(destinations))
+	for i, destination := range destinations {
+		v := map[string]interface{}{
+			"type":             derefString(destination.Type),
+			"address":          derefString(destination.Address),
+			"format":           derefString(destination.Format),
+			"apply_formatting": derefBool(destination.ApplyFormatting),
+			"apply_vis":        derefBool(destination.ApplyVis),
+			"message":          derefString(destination.Message),
+			"parameters":       derefString(destination.Parameters),
+		}
+		if i < len(current) && current[i] != nil {
+			v["secret_parameters"] = current[i].(map[string]interface{})["secret_parameters"]
+		}
+		vs = append(vs, v)
+	}
+	return vs
+}
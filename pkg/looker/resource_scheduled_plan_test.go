@@ -0,0 +1,136 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcc_ScheduledPlan(t *testing.T) {
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: scheduledPlanConfig(name, "0 6 * * 1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "name", name),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "crontab", "0 6 * * 1"),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "destination.#", "1"),
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "destination.0.format", "csv"),
+				),
+			},
+			{
+				Config: scheduledPlanConfig(name, "0 7 * * 1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_scheduled_plan.test", "crontab", "0 7 * * 1"),
+				),
+			},
+			{
+				ResourceName:      "looker_scheduled_plan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccCheckScheduledPlanDestroy,
+	})
+}
+
+func TestScheduledPlanSwitchTrigger(t *testing.T) {
+	api := &mockSettingsAPI{config: map[string]interface{}{"id": "1"}}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"name":    "Weekly users",
+		"look_id": "2",
+		"crontab": "0 6 * * 1",
+		"destination": []interface{}{
+			map[string]interface{}{
+				"type":    "email",
+				"address": "test@example.com",
+				"format":  "csv",
+			},
+		},
+	}
+
+	state, diags := applyTestResourceChange(t, resourceScheduledPlan(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.NotContains(t, api.updates[0], "datagroup")
+	assert.NotContains(t, api.updates[0], "dashboard_id")
+
+	// switching to a datagroup clears the crontab
+	delete(config, "crontab")
+	config["datagroup"] = "nightly"
+
+	state, diags = applyTestResourceChange(t, resourceScheduledPlan(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "", api.updates[1]["crontab"])
+	assert.Equal(t, "nightly", api.updates[1]["datagroup"])
+	assert.Equal(t, "", state.Attributes["crontab"])
+	assert.Equal(t, "nightly", state.Attributes["datagroup"])
+
+	// switching to a dashboard clears the look
+	delete(config, "look_id")
+	config["dashboard_id"] = "3"
+
+	_, diags = applyTestResourceChange(t, resourceScheduledPlan(), state, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "", api.updates[2]["look_id"])
+	assert.Equal(t, "3", api.updates[2]["dashboard_id"])
+	assert.NotContains(t, api.updates[2], "crontab")
+}
+
+func testAccCheckScheduledPlanDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_scheduled_plan" {
+			continue
+		}
+
+		_, err := client.ScheduledPlan(rs.Primary.ID, "", nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("scheduled plan still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func scheduledPlanConfig(name, crontab string) string {
+	return fmt.Sprintf(`
+	resource "looker_look" "scheduled_plan_test" {
+		title     = "%s"
+		folder_id = "1"
+		query {
+			model  = "system__activity"
+			view   = "user"
+			fields = ["user.count"]
+		}
+	}
+	resource "looker_scheduled_plan" "test" {
+		name     = "%s"
+		look_id  = looker_look.scheduled_plan_test.id
+		crontab  = "%s"
+		timezone = "UTC"
+		destination {
+			type    = "email"
+			address = "test@example.com"
+			format  = "csv"
+		}
+	}
+	`, name, name, crontab)
+}
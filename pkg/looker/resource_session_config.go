@@ -0,0 +1,173 @@
+package looker
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// sessionConfigID is the ID of the only session configuration of a Looker
+// instance.
+const sessionConfigID = "session_config"
+
+// Creating the session configuration adopts the current one, so attributes
+// that aren't configured keep their value, and destroying it resets it to the
+// defaults of Looker.
+func resourceSessionConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSessionConfigCreate,
+		ReadContext:   resourceSessionConfigRead,
+		UpdateContext: resourceSessionConfigUpdate,
+		DeleteContext: resourceSessionConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"session_minutes": {
+				Type:         schema.TypeInt,
+				Description:  "Length of user sessions in minutes. Looker defaults to 1440, i.e. a day.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 43200),
+			},
+			"allow_persistent_sessions": {
+				Type:        schema.TypeBool,
+				Description: "Allow users to keep their session when closing their browser.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"unlimited_sessions_per_user": {
+				Type:        schema.TypeBool,
+				Description: "Allow users to have any number of concurrent sessions, instead of only one.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"use_inactivity_based_logout": {
+				Type:        schema.TypeBool,
+				Description: "Log users out after 15 minutes of inactivity.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"track_session_location": {
+				Type:        schema.TypeBool,
+				Description: "Track the location users log in from.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSessionConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Update session config")
+
+	_, err := client.UpdateSessionConfig(expandWriteSessionConfig(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(sessionConfigID)
+
+	return resourceSessionConfigRead(ctx, d, m)
+}
+
+func resourceSessionConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	sessionConfig, err := client.SessionConfig(nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("session_minutes", sessionConfig.SessionMinutes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("allow_persistent_sessions", sessionConfig.AllowPersistentSessions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("unlimited_sessions_per_user", sessionConfig.UnlimitedSessionsPerUser); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("use_inactivity_based_logout", sessionConfig.UseInactivityBasedLogout); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("track_session_location", sessionConfig.TrackSessionLocation); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSessionConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Update session config")
+
+	_, err := client.UpdateSessionConfig(expandWriteSessionConfig(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSessionConfigRead(ctx, d, m)
+}
+
+func resourceSessionConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	log.Printf("[DEBUG] Reset session config")
+
+	sessionMinutes := int64(1440)
+	allowPersistentSessions := true
+	unlimitedSessionsPerUser := true
+	useInactivityBasedLogout := false
+	trackSessionLocation := false
+
+	_, err := client.UpdateSessionConfig(apiclient.WriteSessionConfig{
+		SessionMinutes:           &sessionMinutes,
+		AllowPersistentSessions:  &allowPersistentSessions,
+		UnlimitedSessionsPerUser: &unlimitedSessionsPerUser,
+		UseInactivityBasedLogout: &useInactivityBasedLogout,
+		TrackSessionLocation:     &trackSessionLocation,
+	}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// expandWriteSessionConfig only returns the configured attributes, so that
+// the others keep their current value.
+func expandWriteSessionConfig(d *schema.ResourceData) apiclient.WriteSessionConfig {
+	var writeSessionConfig apiclient.WriteSessionConfig
+
+	if configIsSet(d, "session_minutes") {
+		sessionMinutes := int64(d.Get("session_minutes").(int))
+		writeSessionConfig.SessionMinutes = &sessionMinutes
+	}
+	if configIsSet(d, "allow_persistent_sessions") {
+		allowPersistentSessions := d.Get("allow_persistent_sessions").(bool)
+		writeSessionConfig.AllowPersistentSessions = &allowPersistentSessions
+	}
+	if configIsSet(d, "unlimited_sessions_per_user") {
+		unlimitedSessionsPerUser := d.Get("unlimited_sessions_per_user").(bool)
+		writeSessionConfig.UnlimitedSessionsPerUser = &unlimitedSessionsPerUser
+	}
+	if configIsSet(d, "use_inactivity_based_logout") {
+		useInactivityBasedLogout := d.Get("use_inactivity_based_logout").(bool)
+		writeSessionConfig.UseInactivityBasedLogout = &useInactivityBasedLogout
+	}
+	if configIsSet(d, "track_session_location") {
+		trackSessionLocation := d.Get("track_session_location").(bool)
+		writeSessionConfig.TrackSessionLocation = &trackSessionLocation
+	}
+
+	return writeSessionConfig
+}
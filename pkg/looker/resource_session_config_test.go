@@ -0,0 +1,54 @@
+package looker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionConfigLifecycle(t *testing.T) {
+	api := &mockSettingsAPI{config: map[string]interface{}{
+		"session_minutes":             480,
+		"allow_persistent_sessions":   true,
+		"unlimited_sessions_per_user": true,
+		"use_inactivity_based_logout": true,
+		"track_session_location":      false,
+	}}
+	client := newTestClient(t, api.ServeHTTP)
+
+	config := map[string]interface{}{
+		"session_minutes":           720,
+		"allow_persistent_sessions": false,
+	}
+
+	// creating the resource adopts the attributes that aren't configured
+	state, diags := applyTestResourceChange(t, resourceSessionConfig(), nil, config, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, sessionConfigID, state.ID)
+	assert.Equal(t, map[string]interface{}{"session_minutes": float64(720), "allow_persistent_sessions": false}, api.updates[0])
+	assert.Equal(t, "720", state.Attributes["session_minutes"])
+	assert.Equal(t, "false", state.Attributes["allow_persistent_sessions"])
+	assert.Equal(t, "true", state.Attributes["use_inactivity_based_logout"])
+
+	// reading the configuration back doesn't show any changes
+	diff, err := resourceSessionConfig().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "%v", diff)
+
+	// out of range session lengths are rejected
+	diags = resourceSessionConfig().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{"session_minutes": 1}))
+	assert.True(t, diags.HasError())
+
+	// destroying the resource resets the defaults of Looker
+	diags = resourceSessionConfig().DeleteContext(context.Background(), resourceSessionConfig().Data(state), client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, map[string]interface{}{
+		"session_minutes":             float64(1440),
+		"allow_persistent_sessions":   true,
+		"unlimited_sessions_per_user": true,
+		"use_inactivity_based_logout": false,
+		"track_session_location":      false,
+	}, api.config)
+}
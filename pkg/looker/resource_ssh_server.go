@@ -0,0 +1,168 @@
+package looker
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceSshServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSshServerCreate,
+		ReadContext:   resourceSshServerRead,
+		UpdateContext: resourceSshServerUpdate,
+		DeleteContext: resourceSshServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Hostname or IP address of the SSH server.",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      22,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "User Looker connects to the SSH server as.",
+				Required:    true,
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "SSH public key of the Looker instance, to add to the `authorized_keys` of `username`.",
+				Computed:    true,
+			},
+			"finger_print": {
+				Type:        schema.TypeString,
+				Description: "MD5 fingerprint of the SSH server's host key.",
+				Computed:    true,
+			},
+			"sha_finger_print": {
+				Type:        schema.TypeString,
+				Description: "SHA fingerprint of the SSH server's host key.",
+				Computed:    true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSshServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	body := expandWriteSshServer(d)
+
+	log.Printf("[DEBUG] Create SSH server %s", *body.SshServerName)
+
+	sshServer, err := client.CreateSshServer(body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*sshServer.SshServerId)
+
+	return resourceSshServerRead(ctx, d, m)
+}
+
+func resourceSshServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	sshServer, err := client.SshServer(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("name", sshServer.SshServerName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("host", sshServer.SshServerHost); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("port", sshServer.SshServerPort); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("username", sshServer.SshServerUser); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("public_key", sshServer.PublicKey); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("finger_print", sshServer.FingerPrint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("sha_finger_print", sshServer.ShaFingerPrint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", sshServer.Status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSshServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	sshServerID := d.Id()
+	body := expandWriteSshServer(d)
+
+	log.Printf("[DEBUG] Update SSH server %s", sshServerID)
+
+	_, err := client.UpdateSshServer(sshServerID, body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSshServerRead(ctx, d, m)
+}
+
+func resourceSshServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	sshServerID := d.Id()
+
+	log.Printf("[DEBUG] Delete SSH server %s", sshServerID)
+
+	_, err := client.DeleteSshServer(sshServerID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandWriteSshServer(d *schema.ResourceData) apiclient.WriteSshServer {
+	name := d.Get("name").(string)
+	host := d.Get("host").(string)
+	port := int64(d.Get("port").(int))
+	username := d.Get("username").(string)
+
+	return apiclient.WriteSshServer{
+		SshServerName: &name,
+		SshServerHost: &host,
+		SshServerPort: &port,
+		SshServerUser: &username,
+	}
+}
@@ -0,0 +1,78 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestAcc_SshServer(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: sshServerConfig(name, 22),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_ssh_server.test", "name", name),
+					resource.TestCheckResourceAttr("looker_ssh_server.test", "port", "22"),
+					resource.TestCheckResourceAttrSet("looker_ssh_server.test", "public_key"),
+				),
+			},
+			{
+				Config: sshServerConfig(name, 2222),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_ssh_server.test", "port", "2222"),
+				),
+			},
+			{
+				ResourceName:      "looker_ssh_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the status reflects the last connection attempt
+				ImportStateVerifyIgnore: []string{"status"},
+			},
+		},
+		CheckDestroy: testAccCheckSshServerDestroy,
+	})
+}
+
+func testAccCheckSshServerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_ssh_server" {
+			continue
+		}
+
+		_, err := client.SshServer(rs.Primary.ID, nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("SSH server still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func sshServerConfig(name string, port int) string {
+	return fmt.Sprintf(`
+	resource "looker_ssh_server" "test" {
+		name     = "%s"
+		host     = "bastion.example.com"
+		port     = %d
+		username = "looker"
+	}
+	`, name, port)
+}
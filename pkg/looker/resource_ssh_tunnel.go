@@ -0,0 +1,150 @@
+package looker
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func resourceSshTunnel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSshTunnelCreate,
+		ReadContext:   resourceSshTunnelRead,
+		UpdateContext: resourceSshTunnelUpdate,
+		DeleteContext: resourceSshTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ssh_server_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the SSH server the tunnel goes through.",
+				Required:    true,
+			},
+			"database_host": {
+				Type:        schema.TypeString,
+				Description: "Hostname or IP address of the database, as seen from the SSH server.",
+				Required:    true,
+			},
+			"database_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"local_host_port": {
+				Type:        schema.TypeInt,
+				Description: "Port on the Looker instance that is forwarded to the database.",
+				Computed:    true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_attempt": {
+				Type:        schema.TypeString,
+				Description: "Time of the last attempt to open the tunnel.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSshTunnelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	body := expandWriteSshTunnel(d)
+
+	log.Printf("[DEBUG] Create SSH tunnel to %s:%d", *body.DatabaseHost, *body.DatabasePort)
+
+	sshTunnel, err := client.CreateSshTunnel(body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*sshTunnel.TunnelId)
+
+	return resourceSshTunnelRead(ctx, d, m)
+}
+
+func resourceSshTunnelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	sshTunnel, err := client.SshTunnel(d.Id(), nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("ssh_server_id", sshTunnel.SshServerId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("database_host", sshTunnel.DatabaseHost); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("database_port", sshTunnel.DatabasePort); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("local_host_port", sshTunnel.LocalHostPort); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", sshTunnel.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("last_attempt", sshTunnel.LastAttempt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSshTunnelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	sshTunnelID := d.Id()
+	body := expandWriteSshTunnel(d)
+
+	log.Printf("[DEBUG] Update SSH tunnel %s", sshTunnelID)
+
+	_, err := client.UpdateSshTunnel(sshTunnelID, body, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSshTunnelRead(ctx, d, m)
+}
+
+func resourceSshTunnelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*apiclient.LookerSDK)
+
+	sshTunnelID := d.Id()
+
+	log.Printf("[DEBUG] Delete SSH tunnel %s", sshTunnelID)
+
+	_, err := client.DeleteSshTunnel(sshTunnelID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandWriteSshTunnel(d *schema.ResourceData) apiclient.WriteSshTunnel {
+	sshServerID := d.Get("ssh_server_id").(string)
+	databaseHost := d.Get("database_host").(string)
+	databasePort := int64(d.Get("database_port").(int))
+
+	return apiclient.WriteSshTunnel{
+		SshServerId:  &sshServerID,
+		DatabaseHost: &databaseHost,
+		DatabasePort: &databasePort,
+	}
+}
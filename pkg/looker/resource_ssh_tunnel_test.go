@@ -0,0 +1,81 @@
+package looker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	apiclient "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestAcc_SshTunnel(t *testing.T) {
+	name := strings.ToLower(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: sshTunnelConfig(name, 5432),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("looker_ssh_tunnel.test", "ssh_server_id", "looker_ssh_server.ssh_tunnel_test", "id"),
+					resource.TestCheckResourceAttr("looker_ssh_tunnel.test", "database_port", "5432"),
+					resource.TestCheckResourceAttrSet("looker_ssh_tunnel.test", "local_host_port"),
+				),
+			},
+			{
+				Config: sshTunnelConfig(name, 5433),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_ssh_tunnel.test", "database_port", "5433"),
+				),
+			},
+			{
+				ResourceName:            "looker_ssh_tunnel.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"status", "last_attempt"},
+			},
+		},
+		CheckDestroy: testAccCheckSshTunnelDestroy,
+	})
+}
+
+func testAccCheckSshTunnelDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*apiclient.LookerSDK)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "looker_ssh_tunnel" {
+			continue
+		}
+
+		_, err := client.SshTunnel(rs.Primary.ID, nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "404") {
+				return nil // successfully destroyed
+			}
+			return err
+		}
+
+		return fmt.Errorf("SSH tunnel still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func sshTunnelConfig(name string, databasePort int) string {
+	return fmt.Sprintf(`
+	resource "looker_ssh_server" "ssh_tunnel_test" {
+		name     = "%s"
+		host     = "bastion.example.com"
+		username = "looker"
+	}
+	resource "looker_ssh_tunnel" "test" {
+		ssh_server_id = looker_ssh_server.ssh_tunnel_test.id
+		database_host = "db.internal"
+		database_port = %d
+	}
+	`, name, databasePort)
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,6 +35,14 @@ func expandStringListFromSet(set interface{}) []string {
 	return strings
 }
 
+func expandStringList(list interface{}) []string {
+	strings := make([]string, 0, len(list.([]interface{})))
+	for _, v := range list.([]interface{}) {
+		strings = append(strings, v.(string))
+	}
+	return strings
+}
+
 func flattenStringList(strings []string) []interface{} {
 	vs := make([]interface{}, 0, len(strings))
 	for _, v := range strings {
@@ -54,6 +63,17 @@ func flattenStringListToSet(strings []string) *schema.Set {
 // 	return ints
 // }
 
+// splitCommaSeparated returns the values of a comma-separated API field.
+func splitCommaSeparated(s *string) []string {
+	values := make([]string, 0)
+	for _, v := range strings.Split(derefString(s), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 func hash(val interface{}) string {
 	if val == nil || val.(string) == "" {
 		return ""
@@ -61,3 +81,75 @@ func hash(val interface{}) string {
 	sha := sha256.Sum256([]byte(val.(string)))
 	return hex.EncodeToString(sha[:])
 }
+
+// hashFile returns the hash of the content of the file at the given path, or
+// of the path itself if the file can't be read.
+func hashFile(val interface{}) string {
+	if val == nil || val.(string) == "" {
+		return ""
+	}
+	content, err := os.ReadFile(val.(string))
+	if err != nil {
+		return hash(val)
+	}
+	return hash(string(content))
+}
+
+func validateFileExists(val interface{}, key string) ([]string, []error) {
+	if _, err := os.Stat(val.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", key, err)}
+	}
+	return nil, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func derefBool(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
+
+func derefInt64(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// configString returns the value of a top-level string attribute as written
+// in the configuration, before any StateFunc or DiffSuppressFunc applies.
+func configString(d *schema.ResourceData, key string) (string, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		v, ok := d.GetOk(key)
+		if !ok {
+			return "", false
+		}
+		return v.(string), true
+	}
+
+	v := config.GetAttr(key)
+	if v.IsNull() || !v.IsKnown() || v.AsString() == "" {
+		return "", false
+	}
+	return v.AsString(), true
+}
+
+// configIsSet reports whether a top-level attribute is set in the
+// configuration, which tells unset attributes from ones set to a zero value.
+func configIsSet(d *schema.ResourceData, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	return !config.GetAttr(key).IsNull()
+}